@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
 	_ "github.com/danubiobwm/company-api/docs"
+	"github.com/danubiobwm/company-api/internal/config"
 	"github.com/danubiobwm/company-api/internal/handlers"
+	"github.com/danubiobwm/company-api/internal/jobs"
 	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/danubiobwm/company-api/internal/services"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
@@ -19,33 +23,14 @@ import (
 // @host localhost:8080
 // @BasePath /api/v1
 
-type Config struct {
-	AppPort string
-	DB      repositories.DBConfig
-}
-
-func loadConfig() Config {
-	return Config{
-		AppPort: getenv("APP_PORT", "8080"),
-		DB: repositories.DBConfig{
-			Host:     getenv("DATABASE_HOST", "db"),
-			Port:     getenv("DATABASE_PORT", "5432"),
-			User:     getenv("DATABASE_USER", "postgres"),
-			Password: getenv("DATABASE_PASSWORD", "postgres"),
-			DBName:   getenv("DATABASE_NAME", "companydb"),
-			SSLMode:  getenv("DATABASE_SSLMODE", "disable"),
-		},
-	}
-}
-
 func main() {
-	config := loadConfig()
+	cfg := config.Load()
 
 	var db *gorm.DB
 	var err error
 	maxRetries := 5
 	for i := 0; i < maxRetries; i++ {
-		db, err = repositories.NewGormDB(config.DB)
+		db, err = repositories.NewGormDB(repositories.DBConfig(cfg.DB))
 		if err == nil {
 			break
 		}
@@ -56,16 +41,32 @@ func main() {
 		log.Fatalf("Failed to connect to database after %d attempts: %v", maxRetries, err)
 	}
 
-	r := setupRouter(db)
+	if cfg.PurgeRetentionDays > 0 && cfg.PurgeIntervalHours > 0 {
+		purge := jobs.NewPurgeJob(
+			repositories.NewUnitOfWork(db),
+			time.Duration(cfg.PurgeRetentionDays)*24*time.Hour,
+			time.Duration(cfg.PurgeIntervalHours)*time.Hour,
+		)
+		go purge.Start(context.Background())
+	}
+	if cfg.TransferIntervalMinutes > 0 {
+		transfers := jobs.NewTransferJob(
+			services.NewColaboradorService(repositories.NewUnitOfWork(db)),
+			time.Duration(cfg.TransferIntervalMinutes)*time.Minute,
+		)
+		go transfers.Start(context.Background())
+	}
+
+	r := setupRouter(db, cfg)
 
-	addr := fmt.Sprintf(":%s", config.AppPort)
+	addr := fmt.Sprintf(":%s", cfg.AppPort)
 	log.Printf("Server starting on %s", addr)
 	if err := r.Run(addr); err != nil {
 		log.Fatalf("Server error: %v", err)
 	}
 }
 
-func setupRouter(db *gorm.DB) *gin.Engine {
+func setupRouter(db *gorm.DB, cfg config.Config) *gin.Engine {
 	if os.Getenv("GIN_MODE") == "release" {
 		gin.SetMode(gin.ReleaseMode)
 	}
@@ -75,14 +76,7 @@ func setupRouter(db *gorm.DB) *gin.Engine {
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
 
-	handlers.RegisterRoutes(r, db)
+	handlers.RegisterRoutes(r, db, cfg)
 
 	return r
 }
-
-func getenv(k, fallback string) string {
-	if v := os.Getenv(k); v != "" {
-		return v
-	}
-	return fallback
-}
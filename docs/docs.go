@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/colaboradores": {
+        "/api/v1/audit": {
             "get": {
-                "description": "Get a paginated list of colaboradores with optional filtering",
+                "description": "Get a paginated list of audit entries for colaborador and departamento changes, newest first. Each entry has the authenticated actor, the actor claimed in X-Actor (administrators only), request ID, operation and the before/after value of every changed field, CPF and RG included. Requires X-Admin-Token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,9 +25,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "colaboradores"
+                    "audit"
                 ],
-                "summary": "List all colaboradores",
+                "summary": "List audit entries",
                 "parameters": [
                     {
                         "type": "integer",
@@ -38,33 +38,180 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 100,
-                        "description": "Items per page",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (e.g. -created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "colaborador",
+                            "departamento"
+                        ],
+                        "type": "string",
+                        "description": "Filter by entity",
+                        "name": "entidade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by entity ID (UUID)",
+                        "name": "entidade_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by authenticated actor (admin or anonimo)",
+                        "name": "ator",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the actor claimed in X-Actor by an administrator",
+                        "name": "ator_declarado",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "restore"
+                        ],
+                        "type": "string",
+                        "description": "Filter by operation",
+                        "name": "operacao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by request ID",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries at or after this instant (YYYY-MM-DD or RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries before this instant (YYYY-MM-DD or RFC 3339)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lista de colaboradores e total",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-models_AuditLog"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Acesso restrito a administradores",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/cargos": {
+            "get": {
+                "description": "Get a paginated list of cargos from the catalogue with optional filtering and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cargos"
+                ],
+                "summary": "List all cargos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (e.g. titulo,-salario_max)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by title (partial, case-insensitive)",
+                        "name": "titulo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by level",
+                        "name": "nivel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by CBO code",
+                        "name": "cbo",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-models_Cargo"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new colaborador with the provided data",
+                "description": "Add a cargo (title, level, CBO code and salary band) to the catalogue",
                 "consumes": [
                     "application/json"
                 ],
@@ -72,17 +219,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "colaboradores"
+                    "cargos"
                 ],
-                "summary": "Create a new colaborador",
+                "summary": "Create a new cargo",
                 "parameters": [
                     {
-                        "description": "Colaborador data",
-                        "name": "colaborador",
+                        "description": "Cargo data",
+                        "name": "cargo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Colaborador"
+                            "$ref": "#/definitions/models.Cargo"
                         }
                     }
                 ],
@@ -90,33 +237,33 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Colaborador"
+                            "$ref": "#/definitions/models.Cargo"
                         }
                     },
                     "400": {
                         "description": "Erro de validação",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Cargo já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "422": {
                         "description": "Entidade não processável",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/colaboradores/{id}": {
+        "/api/v1/cargos/{id}": {
             "get": {
-                "description": "Get detailed information about a specific colaborador",
+                "description": "Get a cargo from the catalogue",
                 "consumes": [
                     "application/json"
                 ],
@@ -124,13 +271,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "colaboradores"
+                    "cargos"
                 ],
-                "summary": "Get colaborador by ID",
+                "summary": "Get cargo by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Colaborador ID (UUID)",
+                        "description": "Cargo ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -140,40 +287,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Colaborador"
+                            "$ref": "#/definitions/models.Cargo"
                         }
                     },
                     "400": {
                         "description": "ID inválido",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Colaborador não encontrado",
+                        "description": "Cargo não encontrado",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an existing colaborador by ID",
+                "description": "Update an existing cargo by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -181,24 +319,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "colaboradores"
+                    "cargos"
                 ],
-                "summary": "Update a colaborador",
+                "summary": "Update a cargo",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Colaborador ID (UUID)",
+                        "description": "Cargo ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Colaborador data",
-                        "name": "colaborador",
+                        "description": "Cargo data",
+                        "name": "cargo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Colaborador"
+                            "$ref": "#/definitions/models.Cargo"
                         }
                     }
                 ],
@@ -206,31 +344,37 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Colaborador"
+                            "$ref": "#/definitions/models.Cargo"
                         }
                     },
                     "400": {
                         "description": "Erro de validação",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Cargo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Cargo já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "422": {
                         "description": "Entidade não processável",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a colaborador by ID",
+                "description": "Delete a cargo by ID. Cargos still held by colaboradores are refused with 409",
                 "consumes": [
                     "application/json"
                 ],
@@ -238,13 +382,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "colaboradores"
+                    "cargos"
                 ],
-                "summary": "Delete a colaborador",
+                "summary": "Delete a cargo",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Colaborador ID (UUID)",
+                        "description": "Cargo ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -257,27 +401,33 @@ const docTemplate = `{
                     "400": {
                         "description": "ID inválido",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Cargo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Cargo possui colaboradores",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/departamentos": {
+        "/api/v1/colaboradores": {
             "get": {
-                "description": "Get a list of all departamentos",
+                "description": "Get a paginated list of colaboradores with optional filtering and sorting.\nTerminated colaboradores are left out unless status=desligado or include_terminated=true.\nWith mode=cursor (or a cursor) the listing uses keyset pagination on (created_at, id) and returns next_cursor.\nCPF is returned formatted (529.982.247-25); when MASK_CPF is set, callers without X-Admin-Token get it masked (***.982.247-**).",
                 "consumes": [
                     "application/json"
                 ],
@@ -285,32 +435,144 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "departamentos"
+                    "colaboradores"
+                ],
+                "summary": "List all colaboradores",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (offset mode)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at). Cursor mode only accepts created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "Pagination mode",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned as next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include total count in cursor mode",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial, case-insensitive)",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by CPF, with or without punctuation",
+                        "name": "cpf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by RG",
+                        "name": "rg",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by corporate or personal email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by departamento ID (UUID)",
+                        "name": "departamento_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by cargo ID (UUID)",
+                        "name": "cargo_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ativo",
+                            "afastado",
+                            "desligado"
+                        ],
+                        "type": "string",
+                        "description": "Filter by employment status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include terminated colaboradores when no status is given",
+                        "name": "include_terminated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft-deleted colaboradores (requires X-Admin-Token)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all departamentos",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Departamento"
-                            }
+                            "$ref": "#/definitions/pagination.Response-handlers_ColaboradorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Acesso de administrador necessário",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new departamento with the provided data",
+                "description": "Create a new colaborador with the provided data\nCPF is accepted with or without punctuation and stored as digits only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -318,17 +580,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "departamentos"
+                    "colaboradores"
                 ],
-                "summary": "Create a new departamento",
+                "summary": "Create a new colaborador",
                 "parameters": [
                     {
-                        "description": "Departamento data",
-                        "name": "departamento",
+                        "description": "Colaborador data",
+                        "name": "colaborador",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Departamento"
+                            "$ref": "#/definitions/handlers.ColaboradorCreateRequest"
                         }
                     }
                 ],
@@ -336,33 +598,39 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Departamento"
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created colaborador"
+                            }
                         }
                     },
                     "400": {
                         "description": "Erro de validação",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CPF, RG ou e-mail já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "422": {
                         "description": "Entidade não processável",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/departamentos/{id}": {
+        "/api/v1/colaboradores/{id}": {
             "get": {
-                "description": "Get detailed information about a specific departamento",
+                "description": "Get detailed information about a specific colaborador\nCPF is returned formatted (529.982.247-25); when MASK_CPF is set, callers without X-Admin-Token get it masked (***.982.247-**).",
                 "consumes": [
                     "application/json"
                 ],
@@ -370,56 +638,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "departamentos"
+                    "colaboradores"
                 ],
-                "summary": "Get departamento by ID",
+                "summary": "Get colaborador by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Departamento ID (UUID)",
+                        "description": "Colaborador ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also return a soft-deleted colaborador (requires X-Admin-Token)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag from a previous response; 304 is returned when it still matches",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Departamento"
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the colaborador"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "ID inválido",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Acesso de administrador necessário",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Departamento não encontrado",
+                        "description": "Colaborador não encontrado",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an existing departamento by ID",
+                "description": "Update an existing colaborador by ID.\nSend the ETag from GET in If-Match to update only if nobody changed the colaborador since it was read; a stale ETag returns 412.",
                 "consumes": [
                     "application/json"
                 ],
@@ -427,24 +714,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "departamentos"
+                    "colaboradores"
                 ],
-                "summary": "Update a departamento",
+                "summary": "Update a colaborador",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Departamento ID (UUID)",
+                        "description": "Colaborador ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Departamento data",
-                        "name": "departamento",
+                        "type": "string",
+                        "description": "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Colaborador data",
+                        "name": "colaborador",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Departamento"
+                            "$ref": "#/definitions/handlers.ColaboradorUpdateRequest"
                         }
                     }
                 ],
@@ -452,31 +745,49 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Departamento"
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the colaborador"
+                            }
                         }
                     },
                     "400": {
                         "description": "Erro de validação",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CPF, RG ou e-mail já cadastrado, ou alterado por outra requisição durante a atualização",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "422": {
                         "description": "Entidade não processável",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a departamento by ID",
+                "description": "Soft delete a colaborador by ID. It can be restored until the purge job removes it.\nWith If-Match the deletion only happens if the colaborador is still at that version; otherwise 412 is returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -484,16 +795,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "departamentos"
+                    "colaboradores"
                 ],
-                "summary": "Delete a departamento",
+                "summary": "Delete a colaborador",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Departamento ID (UUID)",
+                        "description": "Colaborador ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deleted (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -503,27 +820,132 @@ const docTemplate = `{
                     "400": {
                         "description": "ID inválido",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Erro interno",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Apply a JSON Merge Patch (RFC 7396): fields present in the body are replaced, fields set to null are cleared and absent fields are kept.\nAccepted fields: nome, cpf, rg, email, email_pessoal, telefone, celular, departamento_id, cargo_id, status and data_admissao. Only rg, email, email_pessoal, telefone, celular, cargo_id and data_admissao accept null.\nChanging departamento_id records a transfer effective today, as in PUT. Termination fields are changed through terminate and rehire.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Partially update a colaborador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the colaborador"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Patch inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Colaborador desligado ou alterado durante a atualização",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Content-Type não suportado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/gerentes/{id}/colaboradores": {
+        "/api/v1/colaboradores/{id}/chain-of-command": {
             "get": {
-                "description": "Get all colaboradores under a gerente's department hierarchy (including sub-departments)",
+                "description": "Walk departamento_superior_id upward from the colaborador's departamento and return each departamento with its gerente, nearest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -531,13 +953,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "gerentes"
+                    "colaboradores"
                 ],
-                "summary": "Get colaboradores under gerente's hierarchy",
+                "summary": "Get a colaborador's chain of command",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Gerente ID (UUID)",
+                        "description": "Colaborador ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -547,23 +969,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GerenteColaboradoresResponse"
+                            "$ref": "#/definitions/services.ChainOfCommand"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "ID inválido",
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Colaborador não encontrado",
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Erro interno",
                         "schema": {
                             "$ref": "#/definitions/handlers.ErrorResponse"
                         }
@@ -571,110 +993,2272 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/health": {
+        "/api/v1/colaboradores/{id}/history": {
             "get": {
-                "description": "Retorna o status de saúde da API",
+                "description": "List every department assignment of the colaborador with valid_from/valid_to (valid_to is exclusive), including scheduled transfers.\nWith as_of only the assignment in effect on that date is returned",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "colaboradores"
+                ],
+                "summary": "Get a colaborador's department history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD) to look up",
+                        "name": "as_of",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.HealthResponse"
+                            "$ref": "#/definitions/services.ColaboradorHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.ColaboradorSummary": {
-            "type": "object",
-            "properties": {
-                "departamento_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "email": {
-                    "type": "string",
-                    "example": "john.doe@company.com"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
-                }
-            }
-        },
-        "handlers.DepartamentoHierarchy": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "nome": {
-                    "type": "string",
-                    "example": "Technology Department"
-                }
-            }
         },
-        "handlers.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Bad Request"
-                },
-                "message": {
+        "/api/v1/colaboradores/{id}/rehire": {
+            "post": {
+                "description": "Reactivate a terminated colaborador with a new admission date (today if omitted)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Rehire a terminated colaborador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rehire data",
+                        "name": "readmissao",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RehireRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Colaborador não está desligado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/colaboradores/{id}/restore": {
+            "post": {
+                "description": "Undo the soft delete of a colaborador. Its departamento must still be active",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Restore a deleted colaborador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Colaborador não está excluído ou departamento excluído",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/colaboradores/{id}/terminate": {
+            "post": {
+                "description": "Mark the colaborador as terminated (desligado) with date and reason. The record is kept and stays retrievable by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Terminate a colaborador",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Termination data",
+                        "name": "desligamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TerminateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ColaboradorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Colaborador já desligado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/colaboradores/{id}/transfers": {
+            "post": {
+                "description": "Move the colaborador to the departamento from data_efetiva (today if omitted). Future dates are scheduled and applied by the transfer job; a new transfer replaces any scheduled one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Transfer a colaborador to another departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer data",
+                        "name": "transferencia",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Colaborador não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Colaborador desligado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Transferência inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/colaboradores/{id}/transfers/scheduled": {
+            "delete": {
+                "description": "Remove the colaborador's pending future transfer and reopen the current assignment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "colaboradores"
+                ],
+                "summary": "Cancel a scheduled transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colaborador ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Nenhuma transferência agendada",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos": {
+            "get": {
+                "description": "Get a paginated list of departamentos with optional filtering and sorting.\nWith mode=cursor (or a cursor) the listing uses keyset pagination on (created_at, id) and returns next_cursor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "List all departamentos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number (offset mode)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at). Cursor mode only accepts created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "description": "Pagination mode",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned as next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include total count in cursor mode",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial, case-insensitive)",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by gerente ID (UUID)",
+                        "name": "gerente_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by parent departamento ID (UUID)",
+                        "name": "departamento_superior_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only departamentos without a parent",
+                        "name": "root",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "gerente"
+                        ],
+                        "type": "string",
+                        "description": "Related data to load",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft-deleted departamentos (requires X-Admin-Token)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-handlers_DepartamentoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Acesso de administrador necessário",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new departamento with the provided data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Create a new departamento",
+                "parameters": [
+                    {
+                        "description": "Departamento data",
+                        "name": "departamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created departamento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/tree": {
+            "get": {
+                "description": "Get every departamento as a nested tree built from departamento_superior_id, with gerente summary and direct/total headcount per node.\nWith as_of the tree is rebuilt as it was at the end of that date from departamento versions and colaborador assignment history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Get the organisational tree",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of levels to return",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "colaboradores"
+                        ],
+                        "type": "string",
+                        "description": "Related data to load",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD) to rebuild the tree for",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.DepartamentoTreeNode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}": {
+            "get": {
+                "description": "Get detailed information about a specific departamento",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Get departamento by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also return a soft-deleted departamento (requires X-Admin-Token)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag from a previous response; 304 is returned when it still matches",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the departamento"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Acesso de administrador necessário",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing departamento by ID.\nSend the ETag from GET in If-Match to update only if nobody changed the departamento since it was read; a stale ETag returns 412.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Update a departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Departamento data",
+                        "name": "departamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the departamento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Alterado por outra requisição durante a atualização",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a departamento by ID. By default (strategy=restrict) the deletion is refused with 409 when the departamento still has colaboradores or sub-departamentos.\nstrategy=reassign moves them to target before deleting; strategy=cascade deletes the whole subtree and its colaboradores.\nDeletions are soft: rows get deleted_at and can be restored until the purge job removes them.\nWith If-Match the deletion only happens if the departamento is still at that version; otherwise 412 is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Delete a departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deleted (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "enum": [
+                            "restrict",
+                            "reassign",
+                            "cascade"
+                        ],
+                        "type": "string",
+                        "default": "restrict",
+                        "description": "Deletion strategy",
+                        "name": "strategy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target departamento ID (UUID), required with strategy=reassign",
+                        "name": "target",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Departamento possui colaboradores ou subdepartamentos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Apply a JSON Merge Patch (RFC 7396): fields present in the body are replaced, fields set to null are cleared and absent fields are kept.\nAccepted fields: nome, descricao, gerente_id and departamento_superior_id; all but nome accept null. A new departamento_superior_id is checked for cycles and maximum depth, as in PUT.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Partially update a departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the departamento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Patch inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Alterado por outra requisição durante a atualização",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "If-Match não corresponde à versão atual",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Content-Type não suportado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match obrigatório",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/ancestors": {
+            "get": {
+                "description": "Walk departamento_superior_id upward and return the ordered path (root first, departamento itself last) with each departamento's gerente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Get the path from the root to a departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/services.DepartamentoPathItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/cargos": {
+            "get": {
+                "description": "List the cargos the departamento has open headcount for, with how many colaboradores already hold each one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cargos"
+                ],
+                "summary": "List a departamento's open headcount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/services.CargoVagas"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/cargos/{cargo_id}": {
+            "put": {
+                "description": "Create or replace the number of open positions the departamento has for the cargo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cargos"
+                ],
+                "summary": "Set open headcount for a cargo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cargo ID (UUID)",
+                        "name": "cargo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Open positions",
+                        "name": "vagas",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VagasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.CargoVagas"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento ou cargo não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop listing the cargo as open headcount in the departamento",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cargos"
+                ],
+                "summary": "Remove a cargo from a departamento's open headcount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cargo ID (UUID)",
+                        "name": "cargo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Cargo sem vagas no departamento",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/merge-into/{target}": {
+            "post": {
+                "description": "Reassign every colaborador and child departamento of the source to the target and delete the source, in a single transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Merge a departamento into another",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target departamento ID (UUID)",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MergeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/move": {
+            "post": {
+                "description": "Re-parent a departamento together with its whole subtree in a single transaction, rejecting cycles and hierarchies deeper than the configured maximum",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Move a departamento subtree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent (null for root)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MoveDepartamentoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Erro de validação",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Entidade não processável",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/restore": {
+            "post": {
+                "description": "Undo the soft delete of a departamento. Its parent must still be active and the hierarchy rules of a move apply. Colaboradores and subdepartamentos deleted with it are not restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Restore a deleted departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Departamento não está excluído",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Hierarquia inválida",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/departamentos/{id}/tree": {
+            "get": {
+                "description": "Get a departamento and all its sub-departamentos as a nested tree, with gerente summary and direct/total headcount per node.\nWith as_of the subtree is rebuilt as it was at the end of that date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departamentos"
+                ],
+                "summary": "Get the tree below a departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Departamento ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of levels to return",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "colaboradores"
+                        ],
+                        "type": "string",
+                        "description": "Related data to load",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD) to rebuild the tree for",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DepartamentoTreeNode"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Departamento não encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/gerentes/{id}/colaboradores": {
+            "get": {
+                "description": "Get all colaboradores under the hierarchies of every departamento the gerente manages (including sub-departments).\nOverlapping subtrees are returned once; via_departamento_id tells which managed departamento each item was reached through.\nWith as_of, departamentos, gerentes and lotações are reconstructed as they were at the end of that date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gerentes"
+                ],
+                "summary": "Get colaboradores under gerente's hierarchy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gerente ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name (partial, case-insensitive)",
+                        "name": "nome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by CPF, with or without punctuation",
+                        "name": "cpf",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by corporate or personal email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by departamento ID (UUID)",
+                        "name": "departamento_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by cargo ID (UUID)",
+                        "name": "cargo_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only colaboradores reached through this managed departamento (UUID)",
+                        "name": "via_departamento_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reconstruct the hierarchy as of this date (YYYY-MM-DD)",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GerenteColaboradoresResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/health": {
+            "get": {
+                "description": "Retorna o status de saúde da API",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/org/diff": {
+            "get": {
+                "description": "Report departamentos created, renamed, re-parented and deleted, gerente changes, and colaboradores hired, transferred or terminated between the end of ` + "`" + `from` + "`" + ` and the end of ` + "`" + `to` + "`" + `.\nBoth dates are compared as snapshots built from departamento versions and colaborador assignment history, so changes undone within the period are not listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Compare the organisation between two dates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.OrgDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "errors.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "cpf"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "cpf"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "cpf inválido"
+                },
+                "param": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "handlers.ColaboradorCreateRequest": {
+            "type": "object",
+            "required": [
+                "cpf",
+                "departamento_id",
+                "nome"
+            ],
+            "properties": {
+                "cargo_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "celular": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "(11) 99999-8888"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "529.982.247-25"
+                },
+                "data_admissao": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "joao.silva@empresa.com.br"
+                },
+                "email_pessoal": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "joao@gmail.com"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "João Silva"
+                },
+                "rg": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "MG1234567"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ativo",
+                        "afastado"
+                    ],
+                    "example": "ativo"
+                },
+                "telefone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "(11) 3333-4444"
+                }
+            }
+        },
+        "handlers.ColaboradorResponse": {
+            "type": "object",
+            "properties": {
+                "cargo_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "celular": {
+                    "type": "string",
+                    "example": "11999998888"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "529.982.247-25"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "data_admissao": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "data_desligamento": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "joao.silva@empresa.com.br"
+                },
+                "email_pessoal": {
+                    "type": "string",
+                    "example": "joao@gmail.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "motivo_desligamento": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "rg": {
+                    "type": "string",
+                    "example": "MG1234567"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ativo"
+                },
+                "telefone": {
+                    "type": "string",
+                    "example": "1133334444"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.ColaboradorSummary": {
+            "type": "object",
+            "properties": {
+                "cargo_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "celular": {
+                    "type": "string",
+                    "example": "11999998888"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john.doe@company.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "telefone": {
+                    "type": "string",
+                    "example": "1133334444"
+                },
+                "via_departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "handlers.ColaboradorUpdateRequest": {
+            "type": "object",
+            "required": [
+                "cpf",
+                "departamento_id",
+                "nome"
+            ],
+            "properties": {
+                "cargo_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "celular": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "(11) 99999-8888"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "529.982.247-25"
+                },
+                "data_admissao": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "joao.silva@empresa.com.br"
+                },
+                "email_pessoal": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "joao@gmail.com"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "João Silva"
+                },
+                "rg": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "MG1234567"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ativo",
+                        "afastado",
+                        "desligado"
+                    ],
+                    "example": "ativo"
+                },
+                "telefone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "(11) 3333-4444"
+                }
+            }
+        },
+        "handlers.DepartamentoHierarchy": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nivel": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "Technology Department"
+                },
+                "via_departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "handlers.DepartamentoRequest": {
+            "type": "object",
+            "required": [
+                "nome"
+            ],
+            "properties": {
+                "departamento_superior_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "descricao": {
+                    "type": "string",
+                    "example": "Infraestrutura e sistemas"
+                },
+                "gerente_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Tecnologia da Informação"
+                }
+            }
+        },
+        "handlers.DepartamentoResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "departamento_superior_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "descricao": {
+                    "type": "string",
+                    "example": "Infraestrutura e sistemas"
+                },
+                "gerente": {
+                    "$ref": "#/definitions/handlers.ColaboradorResponse"
+                },
+                "gerente_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "Tecnologia da Informação"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.DepartamentoTreeNode": {
+            "type": "object",
+            "properties": {
+                "colaboradores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.TreeColaborador"
+                    }
+                },
+                "departamento_superior_id": {
+                    "type": "string"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "gerente": {
+                    "$ref": "#/definitions/services.GerenteSummary"
+                },
+                "headcount_direto": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "headcount_total": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "Tecnologia da Informação"
+                },
+                "subdepartamentos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DepartamentoTreeNode"
+                    }
+                }
+            }
+        },
+        "handlers.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "COLABORADOR_NOT_FOUND"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "colaborador não encontrado"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errors.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/colaboradores/123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "trace_id": {
+                    "type": "string",
+                    "example": "8f14e45f-ceea-467f-a0e6-0b8a2f3c9d71"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "handlers.GerenteColaboradoresResponse": {
+            "type": "object",
+            "properties": {
+                "colaboradores": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ColaboradorSummary"
+                    }
+                },
+                "departamentos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DepartamentoHierarchy"
+                    }
+                },
+                "departamentos_gerenciados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DepartamentoHierarchy"
+                    }
+                },
+                "gerente_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "handlers.HealthResponse": {
+            "description": "Estrutura retornada pelo health check",
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "handlers.MergeResponse": {
+            "type": "object",
+            "properties": {
+                "colaboradores_movidos": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "departamento": {
+                    "$ref": "#/definitions/handlers.DepartamentoResponse"
+                },
+                "subdepartamentos_movidos": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "handlers.MoveDepartamentoRequest": {
+            "type": "object",
+            "properties": {
+                "departamento_superior_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "handlers.RehireRequest": {
+            "type": "object",
+            "properties": {
+                "data_admissao": {
+                    "type": "string",
+                    "example": "2025-09-01"
+                }
+            }
+        },
+        "handlers.TerminateRequest": {
+            "type": "object",
+            "required": [
+                "motivo"
+            ],
+            "properties": {
+                "data_desligamento": {
+                    "type": "string",
+                    "example": "2025-06-30"
+                },
+                "motivo": {
+                    "type": "string",
+                    "example": "pedido de demissão"
+                }
+            }
+        },
+        "handlers.TransferRequest": {
+            "type": "object",
+            "required": [
+                "departamento_id"
+            ],
+            "properties": {
+                "data_efetiva": {
+                    "type": "string",
+                    "example": "2025-11-01"
+                },
+                "departamento_id": {
                     "type": "string",
-                    "example": "Invalid input data"
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "motivo": {
+                    "type": "string",
+                    "example": "reestruturação da área"
                 }
             }
         },
-        "handlers.GerenteColaboradoresResponse": {
+        "handlers.TransferResponse": {
             "type": "object",
             "properties": {
-                "colaboradores": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handlers.ColaboradorSummary"
-                    }
+                "agendada": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "departamentos": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handlers.DepartamentoHierarchy"
-                    }
+                "colaborador": {
+                    "$ref": "#/definitions/handlers.ColaboradorResponse"
                 },
-                "gerente_id": {
+                "lotacao": {
+                    "$ref": "#/definitions/models.ColaboradorLotacao"
+                }
+            }
+        },
+        "handlers.TreeColaborador": {
+            "type": "object",
+            "properties": {
+                "cargo_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "529.982.247-25"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "joao.silva@empresa.com.br"
+                },
+                "id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ativo"
                 }
             }
         },
-        "handlers.HealthResponse": {
-            "description": "Estrutura retornada pelo health check",
+        "handlers.VagasRequest": {
             "type": "object",
+            "required": [
+                "vagas"
+            ],
             "properties": {
-                "status": {
+                "vagas": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 3
+                }
+            }
+        },
+        "models.AuditLog": {
+            "type": "object",
+            "properties": {
+                "alteracoes": {
+                    "type": "object"
+                },
+                "ator": {
                     "type": "string",
-                    "example": "ok"
+                    "example": "admin"
+                },
+                "ator_declarado": {
+                    "type": "string",
+                    "example": "maria.souza"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entidade": {
+                    "type": "string",
+                    "example": "colaborador"
+                },
+                "entidade_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "operacao": {
+                    "type": "string",
+                    "example": "update"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f0c9e0e-3c4b-4b7e-9d59-8a3f0f1b2c3d"
                 }
             }
         },
-        "models.Colaborador": {
+        "models.Cargo": {
             "type": "object",
             "properties": {
-                "cpf": {
+                "cbo": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nivel": {
+                    "type": "string"
+                },
+                "salario_max": {
+                    "type": "number"
+                },
+                "salario_min": {
+                    "type": "number"
+                },
+                "titulo": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ColaboradorLotacao": {
+            "type": "object",
+            "properties": {
+                "colaborador_id": {
                     "type": "string"
                 },
                 "created_at": {
@@ -686,47 +3270,294 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "nome": {
+                "motivo": {
                     "type": "string"
                 },
-                "rg": {
+                "valid_from": {
                     "type": "string"
                 },
-                "updated_at": {
+                "valid_to": {
                     "type": "string"
                 }
             }
         },
-        "models.Departamento": {
+        "pagination.Links": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "first": {
                     "type": "string"
                 },
-                "departamento_superior_id": {
+                "last": {
                     "type": "string"
                 },
-                "descricao": {
+                "next": {
                     "type": "string"
                 },
-                "gerente": {
-                    "description": "relations (for preload)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.Colaborador"
-                        }
-                    ]
+                "prev": {
+                    "type": "string"
                 },
-                "gerente_id": {
+                "self": {
+                    "type": "string"
+                }
+            }
+        },
+        "pagination.Meta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "pagination.Response-handlers_ColaboradorResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ColaboradorResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-handlers_DepartamentoResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DepartamentoResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-models_AuditLog": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditLog"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-models_Cargo": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Cargo"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "services.CargoVagas": {
+            "type": "object",
+            "properties": {
+                "cargo": {
+                    "$ref": "#/definitions/models.Cargo"
+                },
+                "ocupados": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "vagas": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "services.ChainOfCommand": {
+            "type": "object",
+            "properties": {
+                "cadeia": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.DepartamentoPathItem"
+                    }
+                },
+                "colaborador_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "services.ColaboradorHistory": {
+            "type": "object",
+            "properties": {
+                "as_of": {
                     "type": "string"
                 },
+                "colaborador_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "lotacoes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.LotacaoItem"
+                    }
+                }
+            }
+        },
+        "services.DepartamentoPathItem": {
+            "type": "object",
+            "properties": {
+                "gerente": {
+                    "$ref": "#/definitions/services.GerenteSummary"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nivel": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "Tecnologia da Informação"
+                }
+            }
+        },
+        "services.GerenteSummary": {
+            "type": "object",
+            "properties": {
                 "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "nome": {
+                    "type": "string",
+                    "example": "João Silva"
+                }
+            }
+        },
+        "services.LotacaoItem": {
+            "type": "object",
+            "properties": {
+                "agendada": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "departamento_nome": {
+                    "type": "string",
+                    "example": "Tecnologia da Informação"
+                },
+                "motivo": {
                     "type": "string"
                 },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.OrgAlteracao": {
+            "type": "object",
+            "properties": {
+                "de": {
+                    "type": "string",
+                    "example": "Financeiro"
+                },
+                "de_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "departamento_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "entidade_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
                 "nome": {
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "para": {
+                    "type": "string",
+                    "example": "Tecnologia da Informação"
+                },
+                "para_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "tipo": {
+                    "type": "string",
+                    "example": "colaborador_transferido"
+                }
+            }
+        },
+        "services.OrgDiff": {
+            "type": "object",
+            "properties": {
+                "alteracoes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.OrgAlteracao"
+                    }
+                },
+                "from": {
                     "type": "string"
                 },
-                "updated_at": {
+                "resumo": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "to": {
                     "type": "string"
                 }
             }
@@ -0,0 +1,28 @@
+-- V10__colaborador_lotacoes.sql
+-- Histórico de lotação dos colaboradores. Cada linha cobre o intervalo
+-- [valid_from, valid_to); valid_to nulo indica a lotação em aberto e
+-- valid_from no futuro indica uma transferência agendada.
+CREATE TABLE IF NOT EXISTS colaborador_lotacoes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    colaborador_id UUID NOT NULL REFERENCES colaboradores(id) ON DELETE CASCADE,
+    departamento_id UUID NOT NULL REFERENCES departamentos(id) ON DELETE RESTRICT,
+    valid_from DATE NOT NULL,
+    valid_to DATE NULL,
+    motivo VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_colaborador_lotacoes_periodo CHECK (valid_to IS NULL OR valid_to > valid_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_colaborador_lotacoes_colaborador ON colaborador_lotacoes (colaborador_id, valid_from);
+CREATE INDEX IF NOT EXISTS idx_colaborador_lotacoes_departamento ON colaborador_lotacoes (departamento_id, valid_from);
+
+-- Lotação inicial de quem já está na base: da admissão (ou do cadastro) até o desligamento.
+INSERT INTO colaborador_lotacoes (colaborador_id, departamento_id, valid_from, valid_to)
+SELECT c.id,
+       c.departamento_id,
+       COALESCE(c.data_admissao, c.created_at::date, CURRENT_DATE),
+       CASE WHEN c.data_desligamento IS NOT NULL
+            THEN GREATEST(c.data_desligamento + 1, COALESCE(c.data_admissao, c.created_at::date, CURRENT_DATE) + 1)
+       END
+FROM colaboradores c
+WHERE NOT EXISTS (SELECT 1 FROM colaborador_lotacoes l WHERE l.colaborador_id = c.id);
@@ -0,0 +1,65 @@
+-- V11__departamento_versoes.sql
+-- Versões temporais dos departamentos (nome, descrição, superior e gerente).
+-- Cada linha vale no intervalo [valid_from, valid_to); a versão vigente tem
+-- valid_to nulo. O gatilho abaixo grava uma versão a cada inclusão ou alteração
+-- desses campos e encerra a vigente quando o departamento é excluído, inclusive
+-- nas alterações em massa (fusões, realocações) e nos efeitos de FKs.
+CREATE TABLE IF NOT EXISTS departamento_versoes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    departamento_id UUID NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    descricao TEXT,
+    gerente_id UUID NULL,
+    departamento_superior_id UUID NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_departamento_versoes_departamento ON departamento_versoes (departamento_id, valid_from);
+CREATE INDEX IF NOT EXISTS idx_departamento_versoes_periodo ON departamento_versoes (valid_from, valid_to);
+
+CREATE OR REPLACE FUNCTION registrar_versao_departamento() RETURNS trigger AS $$
+DECLARE
+    dept_id UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        dept_id := OLD.id;
+    ELSE
+        dept_id := NEW.id;
+    END IF;
+
+    IF TG_OP = 'UPDATE'
+       AND NEW.nome IS NOT DISTINCT FROM OLD.nome
+       AND NEW.descricao IS NOT DISTINCT FROM OLD.descricao
+       AND NEW.gerente_id IS NOT DISTINCT FROM OLD.gerente_id
+       AND NEW.departamento_superior_id IS NOT DISTINCT FROM OLD.departamento_superior_id
+       AND NEW.deleted_at IS NOT DISTINCT FROM OLD.deleted_at THEN
+        RETURN NULL;
+    END IF;
+
+    -- várias alterações na mesma transação geram uma única versão
+    DELETE FROM departamento_versoes
+    WHERE departamento_id = dept_id AND valid_to IS NULL AND valid_from = now();
+
+    UPDATE departamento_versoes SET valid_to = now()
+    WHERE departamento_id = dept_id AND valid_to IS NULL;
+
+    IF TG_OP <> 'DELETE' AND NEW.deleted_at IS NULL THEN
+        INSERT INTO departamento_versoes (departamento_id, nome, descricao, gerente_id, departamento_superior_id, valid_from)
+        VALUES (NEW.id, NEW.nome, NEW.descricao, NEW.gerente_id, NEW.departamento_superior_id, now());
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_departamento_versoes ON departamentos;
+CREATE TRIGGER trg_departamento_versoes
+    AFTER INSERT OR UPDATE OR DELETE ON departamentos
+    FOR EACH ROW EXECUTE FUNCTION registrar_versao_departamento();
+
+-- Versão inicial dos departamentos existentes, vigente desde o cadastro.
+INSERT INTO departamento_versoes (departamento_id, nome, descricao, gerente_id, departamento_superior_id, valid_from, valid_to)
+SELECT d.id, d.nome, d.descricao, d.gerente_id, d.departamento_superior_id,
+       COALESCE(d.created_at, now()), d.deleted_at
+FROM departamentos d
+WHERE NOT EXISTS (SELECT 1 FROM departamento_versoes v WHERE v.departamento_id = d.id);
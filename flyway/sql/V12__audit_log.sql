@@ -0,0 +1,18 @@
+-- V12__audit_log.sql
+-- Trilha de auditoria das alterações em colaboradores e departamentos. Cada
+-- linha é gravada na mesma transação da alteração e traz quem a fez, a
+-- requisição de origem e o valor anterior e o novo de cada campo alterado.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    ator VARCHAR(100) NOT NULL,
+    request_id VARCHAR(64),
+    entidade VARCHAR(30) NOT NULL,
+    entidade_id UUID NOT NULL,
+    operacao VARCHAR(20) NOT NULL,
+    alteracoes JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entidade ON audit_log (entidade, entidade_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_ator ON audit_log (ator, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log (created_at);
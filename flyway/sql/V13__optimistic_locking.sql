@@ -0,0 +1,6 @@
+-- V13__optimistic_locking.sql
+-- Versão de cada registro para controle de concorrência otimista: toda gravação
+-- incrementa a versão e só é aceita se a versão lida ainda for a atual. A API
+-- expõe a versão como ETag e a confere no If-Match.
+ALTER TABLE colaboradores ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE departamentos ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
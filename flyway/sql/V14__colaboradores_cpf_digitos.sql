@@ -0,0 +1,10 @@
+-- V14__colaboradores_cpf_digitos.sql
+-- O CPF é gravado só com dígitos; a API aceita e devolve a forma pontuada.
+-- Remove a pontuação de registros antigos e impede novas gravações fora do
+-- formato. NOT VALID deixa de fora linhas legadas que nem assim seriam um CPF.
+UPDATE colaboradores
+   SET cpf = regexp_replace(cpf, '[.\- ]', '', 'g')
+ WHERE cpf ~ '[.\- ]';
+
+ALTER TABLE colaboradores
+    ADD CONSTRAINT chk_colaboradores_cpf_digitos CHECK (cpf ~ '^[0-9]{11}$') NOT VALID;
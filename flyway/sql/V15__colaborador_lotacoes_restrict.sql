@@ -0,0 +1,10 @@
+-- V15__colaborador_lotacoes_restrict.sql
+-- O histórico de lotações não pode sumir junto com o colaborador: o expurgo
+-- deixa de apagar em cascata e passa a manter quem ainda tem histórico, como
+-- já acontece com os departamentos.
+ALTER TABLE colaborador_lotacoes
+    DROP CONSTRAINT IF EXISTS colaborador_lotacoes_colaborador_id_fkey;
+
+ALTER TABLE colaborador_lotacoes
+    ADD CONSTRAINT colaborador_lotacoes_colaborador_id_fkey
+    FOREIGN KEY (colaborador_id) REFERENCES colaboradores(id) ON DELETE RESTRICT;
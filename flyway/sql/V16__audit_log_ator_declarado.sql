@@ -0,0 +1,6 @@
+-- V16__audit_log_ator_declarado.sql
+-- O ator da auditoria passa a vir só da credencial da requisição. O nome que
+-- um administrador declara em X-Actor fica em coluna própria, ao lado dele.
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS ator_declarado VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_ator_declarado ON audit_log (ator_declarado, created_at);
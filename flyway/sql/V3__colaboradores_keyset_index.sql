@@ -0,0 +1,3 @@
+-- V3__colaboradores_keyset_index.sql
+-- Suporte à paginação por cursor (created_at, id) sem varrer a tabela.
+CREATE INDEX IF NOT EXISTS idx_colaboradores_created_at_id ON colaboradores (created_at, id);
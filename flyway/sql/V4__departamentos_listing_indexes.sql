@@ -0,0 +1,5 @@
+-- V4__departamentos_listing_indexes.sql
+-- Índices para os filtros e a paginação por cursor da listagem de departamentos.
+CREATE INDEX IF NOT EXISTS idx_departamentos_created_at_id ON departamentos (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_departamentos_gerente_id ON departamentos (gerente_id);
+CREATE INDEX IF NOT EXISTS idx_departamentos_superior_id ON departamentos (departamento_superior_id);
@@ -0,0 +1,23 @@
+-- V5__departamento_delete_policies.sql
+-- Excluir um departamento não pode mais apagar os colaboradores em cascata:
+-- a API decide a estratégia (recusar, realocar ou excluir em cascata).
+ALTER TABLE colaboradores
+    DROP CONSTRAINT IF EXISTS colaboradores_departamento_id_fkey;
+
+ALTER TABLE colaboradores
+    ADD CONSTRAINT colaboradores_departamento_id_fkey FOREIGN KEY (departamento_id) REFERENCES departamentos(id) ON DELETE RESTRICT;
+
+-- Registro de cada tentativa de exclusão de departamento
+CREATE TABLE IF NOT EXISTS departamento_exclusoes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    departamento_id UUID NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    estrategia VARCHAR(20) NOT NULL,
+    destino_id UUID NULL,
+    resultado VARCHAR(20) NOT NULL,
+    colaboradores_afetados BIGINT NOT NULL DEFAULT 0,
+    subdepartamentos_afetados BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_departamento_exclusoes_departamento_id ON departamento_exclusoes (departamento_id);
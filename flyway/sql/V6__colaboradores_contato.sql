@@ -0,0 +1,9 @@
+-- V6__colaboradores_contato.sql
+-- E-mail corporativo (único), e-mail pessoal e telefones de contato.
+ALTER TABLE colaboradores
+    ADD COLUMN IF NOT EXISTS email VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS email_pessoal VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS telefone VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS celular VARCHAR(20);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_colaboradores_email ON colaboradores (email);
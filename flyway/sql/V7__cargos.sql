@@ -0,0 +1,29 @@
+-- V7__cargos.sql
+-- Catálogo de cargos, vagas em aberto por departamento e cargo do colaborador.
+CREATE TABLE IF NOT EXISTS cargos (
+    id UUID PRIMARY KEY,
+    titulo VARCHAR(100) NOT NULL,
+    nivel VARCHAR(30),
+    cbo VARCHAR(6),
+    salario_min NUMERIC(12,2),
+    salario_max NUMERIC(12,2),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_cargos_faixa_salarial CHECK (salario_min IS NULL OR salario_max IS NULL OR salario_min <= salario_max)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cargos_titulo_lower ON cargos (LOWER(titulo));
+
+CREATE TABLE IF NOT EXISTS departamento_cargos (
+    departamento_id UUID NOT NULL REFERENCES departamentos(id) ON DELETE CASCADE,
+    cargo_id UUID NOT NULL REFERENCES cargos(id) ON DELETE RESTRICT,
+    vagas INTEGER NOT NULL DEFAULT 0 CHECK (vagas >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (departamento_id, cargo_id)
+);
+
+ALTER TABLE colaboradores
+    ADD COLUMN IF NOT EXISTS cargo_id UUID REFERENCES cargos(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_colaboradores_cargo ON colaboradores (cargo_id);
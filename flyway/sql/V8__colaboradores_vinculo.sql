@@ -0,0 +1,16 @@
+-- V8__colaboradores_vinculo.sql
+-- Ciclo de vida do vínculo: admissão, situação e desligamento.
+-- Colaboradores desligados continuam na base; as listagens padrão os ocultam.
+ALTER TABLE colaboradores
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'ativo',
+    ADD COLUMN IF NOT EXISTS data_admissao DATE,
+    ADD COLUMN IF NOT EXISTS data_desligamento DATE,
+    ADD COLUMN IF NOT EXISTS motivo_desligamento VARCHAR(255);
+
+ALTER TABLE colaboradores
+    ADD CONSTRAINT chk_colaboradores_status CHECK (status IN ('ativo', 'afastado', 'desligado')),
+    ADD CONSTRAINT chk_colaboradores_desligamento CHECK (
+        (status = 'desligado') = (data_desligamento IS NOT NULL)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_colaboradores_status ON colaboradores (status);
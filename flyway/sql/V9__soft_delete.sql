@@ -0,0 +1,12 @@
+-- V9__soft_delete.sql
+-- Exclusão lógica de colaboradores e departamentos. Linhas com deleted_at
+-- preenchido ficam fora das consultas padrão e são removidas de vez pelo
+-- job de expurgo depois do período de retenção.
+ALTER TABLE colaboradores
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+
+ALTER TABLE departamentos
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_colaboradores_deleted_at ON colaboradores (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_departamentos_deleted_at ON departamentos (deleted_at);
@@ -0,0 +1,50 @@
+package audit
+
+import "context"
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	claimedActorKey
+	requestIDKey
+)
+
+// SystemActor é o ator registrado quando a alteração não vem de uma requisição.
+const SystemActor = "sistema"
+
+// WithActor retorna uma cópia de ctx que carrega quem está fazendo a alteração.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor retorna o ator guardado em ctx, ou SystemActor se não houver.
+func Actor(ctx context.Context) string {
+	if v, ok := ctx.Value(actorKey).(string); ok && v != "" {
+		return v
+	}
+	return SystemActor
+}
+
+// WithClaimedActor retorna uma cópia de ctx que carrega o ator declarado pelo
+// cliente, registrado ao lado do ator autenticado e nunca no lugar dele.
+func WithClaimedActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, claimedActorKey, actor)
+}
+
+// ClaimedActor retorna o ator declarado guardado em ctx, ou "" se não houver.
+func ClaimedActor(ctx context.Context) string {
+	v, _ := ctx.Value(claimedActorKey).(string)
+	return v
+}
+
+// WithRequestID retorna uma cópia de ctx que carrega o id da requisição.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID retorna o id da requisição guardado em ctx, ou "" se não houver.
+func RequestID(ctx context.Context) string {
+	v, _ := ctx.Value(requestIDKey).(string)
+	return v
+}
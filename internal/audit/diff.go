@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// ignoredFields não entram no diff: são mantidos pelo banco ou pelo ORM e mudam
+// em toda gravação.
+var ignoredFields = map[string]bool{
+	"id":         true,
+	"version":    true,
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+}
+
+// Diff compara duas versões de uma entidade pelos campos da sua representação
+// JSON. before nil (criação) ou after nil (exclusão) listam todos os campos
+// preenchidos do outro lado. Relações carregadas (objetos aninhados) são ignoradas;
+// o id da relação já aparece como campo próprio.
+func Diff(before, after interface{}) (models.AuditChanges, error) {
+	old, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := models.AuditChanges{}
+	for name, v := range updated {
+		if !reflect.DeepEqual(old[name], v) {
+			changes[name] = models.AuditChange{De: old[name], Para: v}
+		}
+	}
+	for name, v := range old {
+		if _, ok := updated[name]; !ok {
+			changes[name] = models.AuditChange{De: v, Para: nil}
+		}
+	}
+	return changes, nil
+}
+
+// fields converte v em um mapa campo → valor, sem os campos ignorados e sem objetos aninhados.
+func fields(v interface{}) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	if rv := reflect.ValueOf(v); !rv.IsValid() || ((rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Map) && rv.IsNil()) {
+		return m, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for name, value := range m {
+		if _, nested := value.(map[string]interface{}); nested || value == nil || ignoredFields[name] {
+			delete(m, name)
+		}
+	}
+	return m, nil
+}
+
+// NewEntry monta a entrada de auditoria da operação, com ator, ator declarado e
+// request id de ctx.
+// Retorna nil em uma atualização que não mudou nenhum campo.
+func NewEntry(ctx context.Context, entidade string, id uuid.UUID, operacao string, before, after interface{}) (*models.AuditLog, error) {
+	changes, err := Diff(before, after)
+	if err != nil {
+		return nil, err
+	}
+	if operacao == models.AuditUpdate && len(changes) == 0 {
+		return nil, nil
+	}
+	entry := &models.AuditLog{
+		ID:         uuid.New(),
+		Ator:       Actor(ctx),
+		Entidade:   entidade,
+		EntidadeID: id,
+		Operacao:   operacao,
+		Alteracoes: changes,
+	}
+	if claimed := ClaimedActor(ctx); claimed != "" {
+		entry.AtorDeclarado = &claimed
+	}
+	if rid := RequestID(ctx); rid != "" {
+		entry.RequestID = &rid
+	}
+	return entry, nil
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTracksDocumentChanges(t *testing.T) {
+	rg := "123456789"
+	before := &models.Colaborador{ID: uuid.New(), Nome: "Ana", CPF: "52998224725", RG: &rg}
+	after := *before
+	after.CPF = "11144477735"
+	after.RG = nil
+
+	changes, err := Diff(before, &after)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuditChanges{
+		"cpf": {De: "52998224725", Para: "11144477735"},
+		"rg":  {De: "123456789", Para: nil},
+	}, changes)
+}
+
+func TestNewEntrySkipsNoOpUpdateAndKeepsContext(t *testing.T) {
+	ctx := WithRequestID(WithClaimedActor(WithActor(context.Background(), "admin"), "maria"), "req-1")
+	dept := &models.Departamento{ID: uuid.New(), Nome: "TI"}
+
+	entry, err := NewEntry(ctx, models.AuditDepartamento, dept.ID, models.AuditUpdate, dept, dept)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+
+	entry, err = NewEntry(ctx, models.AuditDepartamento, dept.ID, models.AuditCreate, nil, dept)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, "admin", entry.Ator)
+	require.NotNil(t, entry.AtorDeclarado)
+	assert.Equal(t, "maria", *entry.AtorDeclarado)
+	require.NotNil(t, entry.RequestID)
+	assert.Equal(t, "req-1", *entry.RequestID)
+	assert.Equal(t, models.AuditChange{De: nil, Para: "TI"}, entry.Alteracoes["nome"])
+	assert.NotContains(t, entry.Alteracoes, "created_at")
+
+	assert.Equal(t, SystemActor, Actor(context.Background()))
+}
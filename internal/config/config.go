@@ -10,6 +10,33 @@ type Config struct {
 	DB       DBConfig
 	LogLevel string
 	Env      string
+
+	// MaxDepartamentoDepth limita a quantidade de níveis da hierarquia de departamentos.
+	MaxDepartamentoDepth int
+
+	// AdminToken libera operações administrativas (ex.: include_deleted) para quem
+	// enviar o mesmo valor no cabeçalho X-Admin-Token. Vazio desativa o acesso.
+	AdminToken string
+
+	// PurgeRetentionDays é por quantos dias um registro excluído logicamente pode ser
+	// restaurado antes do expurgo definitivo; PurgeIntervalHours é o intervalo entre
+	// execuções do job. O expurgo é opcional: o padrão 0 (ou qualquer valor <= 0)
+	// o desativa e os registros excluídos são mantidos indefinidamente. O expurgo
+	// apaga também o histórico de lotações dos registros removidos.
+	PurgeRetentionDays int
+	PurgeIntervalHours int
+
+	// TransferIntervalMinutes é o intervalo entre as execuções do job que efetiva
+	// as transferências agendadas. Valores <= 0 desativam o job.
+	TransferIntervalMinutes int
+
+	// RequireIfMatch faz PUT, PATCH e DELETE de colaboradores e departamentos
+	// recusarem (428) requisições sem o cabeçalho If-Match.
+	RequireIfMatch bool
+
+	// MaskCPF faz as respostas mascararem o CPF (***.150.753-**) para quem não
+	// envia o X-Admin-Token, único portador do escopo pii.
+	MaskCPF bool
 }
 
 type DBConfig struct {
@@ -34,6 +61,13 @@ func Load() Config {
 			DBName:   getenv("DATABASE_NAME", "companydb"),
 			SSLMode:  getenv("DATABASE_SSLMODE", "disable"),
 		},
+		MaxDepartamentoDepth:    getenvInt("DEPARTAMENTO_MAX_DEPTH", 10),
+		AdminToken:              getenv("ADMIN_TOKEN", ""),
+		PurgeRetentionDays:      getenvInt("PURGE_RETENTION_DAYS", 0),
+		PurgeIntervalHours:      getenvInt("PURGE_INTERVAL_HOURS", 24),
+		TransferIntervalMinutes: getenvInt("TRANSFER_INTERVAL_MINUTES", 60),
+		RequireIfMatch:          getenvBool("REQUIRE_IF_MATCH", false),
+		MaskCPF:                 getenvBool("MASK_CPF", false),
 	}
 }
 
@@ -52,3 +86,12 @@ func getenvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+func getenvBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,91 @@
+// Package cpf valida, normaliza e formata o Cadastro de Pessoas Físicas.
+package cpf
+
+import "strings"
+
+// Normalize remove a pontuação de um CPF (pontos, hífen e espaços), deixando
+// só os dígitos, forma em que ele é gravado e comparado. Outros caracteres são
+// mantidos para que Valid recuse a entrada.
+func Normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', ' ':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Valid indica se s é um CPF com dígitos verificadores corretos, com ou sem
+// pontuação (529.982.247-25 ou 52998224725). Sequências repetidas como
+// 111.111.111-11 são recusadas.
+func Valid(s string) bool {
+	d, ok := digits(s)
+	if !ok {
+		return false
+	}
+	digs := make([]int, 0, 11)
+	for _, r := range d {
+		digs = append(digs, int(r-'0'))
+	}
+	seq := true
+	for i := 1; i < 11; i++ {
+		if digs[i] != digs[0] {
+			seq = false
+			break
+		}
+	}
+	if seq {
+		return false
+	}
+
+	calc := func(digs []int) int {
+		sum := 0
+		for i, v := range digs {
+			sum += v * (len(digs) + 1 - i)
+		}
+		mod := sum % 11
+		if mod < 2 {
+			return 0
+		}
+		return 11 - mod
+	}
+
+	d1 := calc(digs[:9])
+	d2 := calc(append(digs[:9:9], d1))
+	return d1 == digs[9] && d2 == digs[10]
+}
+
+// Format devolve o CPF no formato 000.000.000-00. Valores que não têm 11
+// dígitos depois de normalizados são devolvidos sem alteração.
+func Format(s string) string {
+	d, ok := digits(s)
+	if !ok {
+		return s
+	}
+	return d[0:3] + "." + d[3:6] + "." + d[6:9] + "-" + d[9:11]
+}
+
+// Mask devolve o CPF formatado com os três primeiros dígitos e os verificadores
+// ocultos (***.150.753-**), para quem não pode ver dados pessoais. Valores que
+// não são um CPF são ocultados por inteiro.
+func Mask(s string) string {
+	d, ok := digits(s)
+	if !ok {
+		return "***.***.***-**"
+	}
+	return "***." + d[3:6] + "." + d[6:9] + "-**"
+}
+
+func digits(s string) (string, bool) {
+	d := Normalize(s)
+	if len(d) != 11 {
+		return "", false
+	}
+	for _, r := range d {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	return d, true
+}
@@ -0,0 +1,33 @@
+package cpf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	cases := map[string]bool{
+		"52998224725":    true,
+		"529.982.247-25": true,
+		"52998224724":    false,
+		"52998224735":    false, // primeiro dígito verificador errado
+		"11111111111":    false,
+		"5299822472":     false,
+		"529x98224725":   false,
+		"":               false,
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, Valid(in), in)
+	}
+}
+
+func TestFormatAndMask(t *testing.T) {
+	assert.Equal(t, "00615075398", Normalize("006.150.753-98"))
+	assert.Equal(t, "00615075398", Normalize(" 006150753-98"))
+	assert.Equal(t, "006.150.753-98", Format("00615075398"))
+	assert.Equal(t, "006.150.753-98", Format("006.150.753-98"))
+	assert.Equal(t, "123", Format("123"))
+	assert.Equal(t, "***.150.753-**", Mask("00615075398"))
+	assert.Equal(t, "***.***.***-**", Mask("123"))
+}
@@ -0,0 +1,47 @@
+package errors
+
+// Códigos estáveis dos erros de domínio, usados pelos clientes para tratar cada caso.
+const (
+	CodeDepartamentoNotFound            = "DEPARTAMENTO_NOT_FOUND"
+	CodeDepartamentoSuperiorNotFound    = "DEPARTAMENTO_SUPERIOR_NOT_FOUND"
+	CodeDepartamentoSelfParent          = "DEPARTAMENTO_SELF_PARENT"
+	CodeDepartamentoCycle               = "DEPARTAMENTO_CYCLE"
+	CodeDepartamentoMaxDepth            = "DEPARTAMENTO_MAX_DEPTH"
+	CodeDepartamentoMergeSelf           = "DEPARTAMENTO_MERGE_SELF"
+	CodeDepartamentoNotEmpty            = "DEPARTAMENTO_NOT_EMPTY"
+	CodeInvalidDeleteStrategy           = "INVALID_DELETE_STRATEGY"
+	CodeDepartamentoNotDeleted          = "DEPARTAMENTO_NOT_DELETED"
+	CodeDepartamentoInvalid             = "DEPARTAMENTO_INVALID"
+	CodeDepartamentoGerenteNotFound     = "DEPARTAMENTO_GERENTE_NOT_FOUND"
+	CodeGerenteSemDepartamento          = "GERENTE_SEM_DEPARTAMENTO"
+	CodeColaboradorCPFInvalid           = "COLABORADOR_CPF_INVALID"
+	CodeColaboradorCPFDuplicate         = "COLABORADOR_CPF_DUPLICATE"
+	CodeColaboradorRGDuplicate          = "COLABORADOR_RG_DUPLICATE"
+	CodeColaboradorDepartamentoNotFound = "COLABORADOR_DEPARTAMENTO_NOT_FOUND"
+	CodeColaboradorEmailInvalid         = "COLABORADOR_EMAIL_INVALID"
+	CodeColaboradorEmailDuplicate       = "COLABORADOR_EMAIL_DUPLICATE"
+	CodeColaboradorTelefoneInvalid      = "COLABORADOR_TELEFONE_INVALID"
+	CodeColaboradorCargoNotFound        = "COLABORADOR_CARGO_NOT_FOUND"
+	CodeColaboradorNotFound             = "COLABORADOR_NOT_FOUND"
+	CodeColaboradorStatusInvalid        = "COLABORADOR_STATUS_INVALID"
+	CodeColaboradorTerminated           = "COLABORADOR_TERMINATED"
+	CodeColaboradorNotTerminated        = "COLABORADOR_NOT_TERMINATED"
+	CodeColaboradorDataInvalid          = "COLABORADOR_DATA_INVALID"
+	CodeColaboradorNotDeleted           = "COLABORADOR_NOT_DELETED"
+	CodeColaboradorRestoreBlocked       = "COLABORADOR_RESTORE_BLOCKED"
+	CodeColaboradorTransferInvalid      = "COLABORADOR_TRANSFER_INVALID"
+	CodeColaboradorTransferNotScheduled = "COLABORADOR_TRANSFER_NOT_SCHEDULED"
+	CodeCargoNotFound                   = "CARGO_NOT_FOUND"
+	CodeCargoInvalid                    = "CARGO_INVALID"
+	CodeCargoDuplicate                  = "CARGO_DUPLICATE"
+	CodeCargoInUse                      = "CARGO_IN_USE"
+	CodeOrgDiffPeriodInvalid            = "ORG_DIFF_PERIOD_INVALID"
+	CodeVersionConflict                 = "VERSION_CONFLICT"
+	CodePatchInvalid                    = "PATCH_INVALID"
+	CodeValidationFailed                = "VALIDATION_FAILED"
+	CodeInvalidParameter                = "INVALID_PARAMETER"
+	CodeForbidden                       = "FORBIDDEN"
+	CodePreconditionRequired            = "PRECONDITION_REQUIRED"
+	CodeUnsupportedMediaType            = "UNSUPPORTED_MEDIA_TYPE"
+	CodeInternal                        = "INTERNAL_ERROR"
+)
@@ -6,6 +6,25 @@ import "fmt"
 type DomainError struct {
 	Message string
 	Code    string
+	// Details carrega dados extras para o cliente (por exemplo, contagens em um conflito).
+	Details map[string]interface{}
+	// Fields lista os campos rejeitados quando o erro é de validação.
+	Fields []FieldError
+	// Key identifica a mensagem no catálogo de traduções quando o código sozinho
+	// não a distingue (vários erros compartilham o mesmo código); Params preenche
+	// os marcadores dessa mensagem.
+	Key    string
+	Params map[string]interface{}
+}
+
+// FieldError descreve um campo rejeitado na validação. Code é a regra violada
+// (required, cpf, uuid, max, ...) e Param o argumento da regra, quando houver
+// (o limite de max, as opções de oneof, o campo comparado em ltefield).
+type FieldError struct {
+	Field   string `json:"field" example:"cpf"`
+	Code    string `json:"code" example:"cpf"`
+	Param   string `json:"param,omitempty" example:""`
+	Message string `json:"message" example:"cpf inválido"`
 }
 
 // Error implementa a interface error.
@@ -25,3 +44,29 @@ func New(msg string) *DomainError {
 func NewWithCode(code, msg string) *DomainError {
 	return &DomainError{Code: code, Message: msg}
 }
+
+// WithDetails anexa dados extras ao erro e o retorna, para uso encadeado.
+func (e *DomainError) WithDetails(details map[string]interface{}) *DomainError {
+	e.Details = details
+	return e
+}
+
+// WithField anexa um campo rejeitado ao erro e o retorna, para uso encadeado.
+func (e *DomainError) WithField(field, code, msg string) *DomainError {
+	e.Fields = append(e.Fields, FieldError{Field: field, Code: code, Message: msg})
+	return e
+}
+
+// WithFieldParam é WithField para regras com argumento.
+func (e *DomainError) WithFieldParam(field, code, param, msg string) *DomainError {
+	e.Fields = append(e.Fields, FieldError{Field: field, Code: code, Param: param, Message: msg})
+	return e
+}
+
+// WithKey associa ao erro a mensagem específica key do catálogo de traduções e
+// o retorna, para uso encadeado.
+func (e *DomainError) WithKey(key string, params map[string]interface{}) *DomainError {
+	e.Key = key
+	e.Params = params
+	return e
+}
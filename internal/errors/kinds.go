@@ -0,0 +1,58 @@
+package errors
+
+// Kind classifica um erro de domínio pelo tipo de falha. A camada HTTP traduz
+// cada tipo em um status; os serviços só escolhem o código.
+type Kind int
+
+const (
+	// KindUnprocessable é uma regra de negócio violada por dados bem formados.
+	KindUnprocessable Kind = iota
+	// KindInvalid são dados de entrada malformados ou fora do domínio.
+	KindInvalid
+	// KindNotFound é o recurso pedido que não existe.
+	KindNotFound
+	// KindConflict é uma operação incompatível com o estado atual do recurso.
+	KindConflict
+	// KindPrecondition é uma gravação sobre uma versão desatualizada.
+	KindPrecondition
+)
+
+// codeKinds classifica os códigos estáveis. Códigos ausentes são KindUnprocessable.
+var codeKinds = map[string]Kind{
+	CodeDepartamentoNotFound:            KindNotFound,
+	CodeColaboradorNotFound:             KindNotFound,
+	CodeCargoNotFound:                   KindNotFound,
+	CodeColaboradorTransferNotScheduled: KindNotFound,
+	CodeGerenteSemDepartamento:          KindNotFound,
+
+	CodeDepartamentoNotEmpty:      KindConflict,
+	CodeDepartamentoNotDeleted:    KindConflict,
+	CodeColaboradorTerminated:     KindConflict,
+	CodeColaboradorNotTerminated:  KindConflict,
+	CodeColaboradorNotDeleted:     KindConflict,
+	CodeColaboradorRestoreBlocked: KindConflict,
+	CodeColaboradorEmailDuplicate: KindConflict,
+	CodeColaboradorCPFDuplicate:   KindConflict,
+	CodeColaboradorRGDuplicate:    KindConflict,
+	CodeCargoDuplicate:            KindConflict,
+	CodeCargoInUse:                KindConflict,
+
+	CodeInvalidDeleteStrategy:      KindInvalid,
+	CodeDepartamentoInvalid:        KindInvalid,
+	CodeColaboradorDataInvalid:     KindInvalid,
+	CodeColaboradorCPFInvalid:      KindInvalid,
+	CodeColaboradorEmailInvalid:    KindInvalid,
+	CodeColaboradorTelefoneInvalid: KindInvalid,
+	CodeCargoInvalid:               KindInvalid,
+	CodeOrgDiffPeriodInvalid:       KindInvalid,
+	CodePatchInvalid:               KindInvalid,
+	CodeValidationFailed:           KindInvalid,
+	CodeInvalidParameter:           KindInvalid,
+
+	CodeVersionConflict: KindPrecondition,
+}
+
+// Kind retorna o tipo de falha do erro, pelo seu código.
+func (e *DomainError) Kind() Kind {
+	return codeKinds[e.Code]
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const adminContextKey = "admin"
+
+// AdminAuth marca a requisição como administrativa quando o cabeçalho
+// X-Admin-Token confere com token. Com token vazio ninguém é administrador.
+func AdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given := c.GetHeader("X-Admin-Token")
+		admin := token != "" && subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+		c.Set(adminContextKey, admin)
+		c.Next()
+	}
+}
+
+// isAdmin indica se a requisição foi autenticada por AdminAuth.
+func isAdmin(c *gin.Context) bool {
+	return c.GetBool(adminContextKey)
+}
+
+// includeDeleted lê ?include_deleted, permitido apenas para administradores.
+// Quando retorna ok=false a resposta de erro já foi escrita.
+func includeDeleted(c *gin.Context) (include bool, ok bool) {
+	raw := c.Query("include_deleted")
+	if raw == "" {
+		return false, true
+	}
+	include, err := strconv.ParseBool(raw)
+	if err != nil {
+		writeError(c, invalidParameter("include_deleted", "type", "boolean"))
+		return false, false
+	}
+	if include && !isAdmin(c) {
+		writeKeyedProblem(c, http.StatusForbidden, dderr.CodeForbidden, "acesso.include_deleted", "include_deleted exige acesso de administrador")
+		return false, false
+	}
+	return include, true
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncludeDeletedRequiresAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(AdminAuth("segredo"))
+	router.GET("/itens", func(c *gin.Context) {
+		include, ok := includeDeleted(c)
+		if !ok {
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"include_deleted": include})
+	})
+
+	cases := []struct {
+		query, token string
+		status       int
+	}{
+		{"", "", http.StatusOK},
+		{"?include_deleted=true", "", http.StatusForbidden},
+		{"?include_deleted=true", "errado", http.StatusForbidden},
+		{"?include_deleted=true", "segredo", http.StatusOK},
+		{"?include_deleted=talvez", "segredo", http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", "/itens"+tc.query, nil)
+		if tc.token != "" {
+			req.Header.Set("X-Admin-Token", tc.token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.status, w.Code, tc.query+" "+tc.token)
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	service *services.AuditService
+}
+
+func NewAuditHandler(s *services.AuditService) *AuditHandler {
+	return &AuditHandler{service: s}
+}
+
+// RegisterRoutes registra as rotas da trilha de auditoria
+func (h *AuditHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/audit", h.GetAll)
+}
+
+// AuditListQuery representa os parâmetros aceitos na consulta da trilha de auditoria.
+// from e to aceitam data (AAAA-MM-DD) ou data e hora RFC 3339; o intervalo é [from, to).
+type AuditListQuery struct {
+	Page          int    `form:"page" binding:"omitempty,min=1"`
+	Limit         int    `form:"limit" binding:"omitempty,min=1"`
+	Sort          string `form:"sort"`
+	Entidade      string `form:"entidade" binding:"omitempty,oneof=colaborador departamento"`
+	EntidadeID    string `form:"entidade_id" binding:"omitempty,uuid"`
+	Ator          string `form:"ator"`
+	AtorDeclarado string `form:"ator_declarado"`
+	Operacao      string `form:"operacao" binding:"omitempty,oneof=create update delete restore"`
+	RequestID     string `form:"request_id"`
+	From          string `form:"from"`
+	To            string `form:"to"`
+}
+
+// GetAll godoc
+// @Summary List audit entries
+// @Description Get a paginated list of audit entries for colaborador and departamento changes, newest first. Each entry has the authenticated actor, the actor claimed in X-Actor (administrators only), request ID, operation and the before/after value of every changed field, CPF and RG included. Requires X-Admin-Token.
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (e.g. -created_at)"
+// @Param entidade query string false "Filter by entity" Enums(colaborador, departamento)
+// @Param entidade_id query string false "Filter by entity ID (UUID)"
+// @Param ator query string false "Filter by authenticated actor (admin or anonimo)"
+// @Param ator_declarado query string false "Filter by the actor claimed in X-Actor by an administrator"
+// @Param operacao query string false "Filter by operation" Enums(create, update, delete, restore)
+// @Param request_id query string false "Filter by request ID"
+// @Param from query string false "Entries at or after this instant (YYYY-MM-DD or RFC 3339)"
+// @Param to query string false "Entries before this instant (YYYY-MM-DD or RFC 3339)"
+// @Success 200 {object} pagination.Response[models.AuditLog]
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 403 {object} ErrorResponse "Acesso restrito a administradores"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/audit [get]
+func (h *AuditHandler) GetAll(c *gin.Context) {
+	if !isAdmin(c) {
+		writeKeyedProblem(c, http.StatusForbidden, dderr.CodeForbidden, "acesso.auditoria", "trilha de auditoria exige acesso de administrador")
+		return
+	}
+
+	var q AuditListQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	sort, err := pagination.ParseSort(q.Sort, repositories.AuditSortFields)
+	if err != nil {
+		writeError(c, invalidParameter("sort", "invalid", ""))
+		return
+	}
+	params := pagination.Params{Page: q.Page, Limit: q.Limit, Sort: sort}.Normalize()
+
+	filters := map[string]interface{}{
+		"entidade":       q.Entidade,
+		"entidade_id":    q.EntidadeID,
+		"ator":           q.Ator,
+		"ator_declarado": q.AtorDeclarado,
+		"operacao":       q.Operacao,
+		"request_id":     q.RequestID,
+	}
+	for name, raw := range map[string]string{"from": q.From, "to": q.To} {
+		if raw == "" {
+			continue
+		}
+		t, err := parseInstant(raw)
+		if err != nil {
+			writeError(c, invalidParameter(name, "timestamp", ""))
+			return
+		}
+		filters[name] = t
+	}
+
+	entries, total, err := h.service.List(filters, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, pagination.NewResponse(entries, total, params, c.Request.URL))
+}
+
+// parseInstant aceita uma data (meia-noite UTC) ou data e hora RFC 3339.
+func parseInstant(v string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"net/http"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CargoHandler struct {
+	service *services.CargoService
+}
+
+func NewCargoHandler(s *services.CargoService) *CargoHandler {
+	return &CargoHandler{service: s}
+}
+
+func (h *CargoHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	r := rg.Group("/cargos")
+	r.GET("", h.GetAll)
+	r.GET("/:id", h.GetByID)
+	r.POST("", h.Create)
+	r.PUT("/:id", h.Update)
+	r.DELETE("/:id", h.Delete)
+
+	d := rg.Group("/departamentos")
+	d.GET("/:id/cargos", h.Vagas)
+	d.PUT("/:id/cargos/:cargo_id", h.SetVagas)
+	d.DELETE("/:id/cargos/:cargo_id", h.RemoveVagas)
+}
+
+// CargoListQuery representa os parâmetros aceitos na listagem de cargos.
+type CargoListQuery struct {
+	Page   int    `form:"page" binding:"omitempty,min=1"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1"`
+	Sort   string `form:"sort"`
+	Titulo string `form:"titulo"`
+	Nivel  string `form:"nivel"`
+	CBO    string `form:"cbo"`
+}
+
+// VagasRequest é o corpo aceito ao definir as vagas de um cargo no departamento.
+type VagasRequest struct {
+	Vagas *int `json:"vagas" binding:"required,min=0" example:"3"`
+}
+
+// GetAll godoc
+// @Summary List all cargos
+// @Description Get a paginated list of cargos from the catalogue with optional filtering and sorting
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (e.g. titulo,-salario_max)"
+// @Param titulo query string false "Filter by title (partial, case-insensitive)"
+// @Param nivel query string false "Filter by level"
+// @Param cbo query string false "Filter by CBO code"
+// @Success 200 {object} pagination.Response[models.Cargo]
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/cargos [get]
+func (h *CargoHandler) GetAll(c *gin.Context) {
+	var q CargoListQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	sort, err := pagination.ParseSort(q.Sort, repositories.CargoSortFields)
+	if err != nil {
+		writeError(c, invalidParameter("sort", "invalid", ""))
+		return
+	}
+	params := pagination.Params{Page: q.Page, Limit: q.Limit, Sort: sort}.Normalize()
+
+	filters := map[string]interface{}{
+		"titulo": q.Titulo,
+		"nivel":  q.Nivel,
+		"cbo":    q.CBO,
+	}
+	cargos, total, err := h.service.List(filters, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, pagination.NewResponse(cargos, total, params, c.Request.URL))
+}
+
+// GetByID godoc
+// @Summary Get cargo by ID
+// @Description Get a cargo from the catalogue
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Cargo ID (UUID)"
+// @Success 200 {object} models.Cargo
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Cargo não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/cargos/{id} [get]
+func (h *CargoHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	cargo, err := h.service.GetByID(id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cargo == nil {
+		writeProblem(c, http.StatusNotFound, dderr.CodeCargoNotFound, "cargo não encontrado")
+		return
+	}
+	c.JSON(http.StatusOK, cargo)
+}
+
+// Create godoc
+// @Summary Create a new cargo
+// @Description Add a cargo (title, level, CBO code and salary band) to the catalogue
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param cargo body models.Cargo true "Cargo data"
+// @Success 201 {object} models.Cargo
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 409 {object} ErrorResponse "Cargo já cadastrado"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Router /api/v1/cargos [post]
+func (h *CargoHandler) Create(c *gin.Context) {
+	var cargo models.Cargo
+	if !bindJSON(c, &cargo) {
+		return
+	}
+
+	if err := h.service.Create(&cargo); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, cargo)
+}
+
+// Update godoc
+// @Summary Update a cargo
+// @Description Update an existing cargo by ID
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Cargo ID (UUID)"
+// @Param cargo body models.Cargo true "Cargo data"
+// @Success 200 {object} models.Cargo
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 404 {object} ErrorResponse "Cargo não encontrado"
+// @Failure 409 {object} ErrorResponse "Cargo já cadastrado"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Router /api/v1/cargos/{id} [put]
+func (h *CargoHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	var cargo models.Cargo
+	if !bindJSON(c, &cargo) {
+		return
+	}
+	cargo.ID = id
+
+	if err := h.service.Update(&cargo); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, cargo)
+}
+
+// Delete godoc
+// @Summary Delete a cargo
+// @Description Delete a cargo by ID. Cargos still held by colaboradores are refused with 409
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Cargo ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Cargo não encontrado"
+// @Failure 409 {object} ErrorResponse "Cargo possui colaboradores"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/cargos/{id} [delete]
+func (h *CargoHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	if err := h.service.Delete(id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Vagas godoc
+// @Summary List a departamento's open headcount
+// @Description List the cargos the departamento has open headcount for, with how many colaboradores already hold each one
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Success 200 {array} services.CargoVagas
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/cargos [get]
+func (h *CargoHandler) Vagas(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	vagas, err := h.service.Vagas(id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, vagas)
+}
+
+// SetVagas godoc
+// @Summary Set open headcount for a cargo
+// @Description Create or replace the number of open positions the departamento has for the cargo
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Param cargo_id path string true "Cargo ID (UUID)"
+// @Param vagas body VagasRequest true "Open positions"
+// @Success 200 {object} services.CargoVagas
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 404 {object} ErrorResponse "Departamento ou cargo não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/cargos/{cargo_id} [put]
+func (h *CargoHandler) SetVagas(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	cargoID, err := uuid.Parse(c.Param("cargo_id"))
+	if err != nil {
+		writeError(c, invalidParameter("cargo_id", "uuid", ""))
+		return
+	}
+
+	var req VagasRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.service.SetVagas(id, cargoID, *req.Vagas)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// RemoveVagas godoc
+// @Summary Remove a cargo from a departamento's open headcount
+// @Description Stop listing the cargo as open headcount in the departamento
+// @Tags cargos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Param cargo_id path string true "Cargo ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Cargo sem vagas no departamento"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/cargos/{cargo_id} [delete]
+func (h *CargoHandler) RemoveVagas(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	cargoID, err := uuid.Parse(c.Param("cargo_id"))
+	if err != nil {
+		writeError(c, invalidParameter("cargo_id", "uuid", ""))
+		return
+	}
+
+	if err := h.service.RemoveVagas(id, cargoID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/google/uuid"
+)
+
+// ColaboradorDados são os campos aceitos tanto na criação quanto na atualização
+// completa de um colaborador. id, versão e datas de controle não vêm do cliente.
+type ColaboradorDados struct {
+	Nome           string     `json:"nome" binding:"required,max=100" example:"João Silva"`
+	CPF            string     `json:"cpf" binding:"required,cpf" example:"529.982.247-25"`
+	RG             *string    `json:"rg" binding:"omitempty,max=50" example:"MG1234567"`
+	Email          *string    `json:"email" binding:"omitempty,max=255,email" example:"joao.silva@empresa.com.br"`
+	EmailPessoal   *string    `json:"email_pessoal" binding:"omitempty,max=255,email" example:"joao@gmail.com"`
+	Telefone       *string    `json:"telefone" binding:"omitempty,max=20" example:"(11) 3333-4444"`
+	Celular        *string    `json:"celular" binding:"omitempty,max=20" example:"(11) 99999-8888"`
+	DepartamentoID string     `json:"departamento_id" binding:"required,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CargoID        *string    `json:"cargo_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DataAdmissao   *time.Time `json:"data_admissao" example:"2024-03-01T00:00:00Z"`
+}
+
+// ColaboradorCreateRequest é o corpo de POST /colaboradores. Sem status, o
+// colaborador é criado ativo.
+type ColaboradorCreateRequest struct {
+	ColaboradorDados
+	Status string `json:"status" binding:"omitempty,oneof=ativo afastado" example:"ativo"`
+}
+
+// ColaboradorUpdateRequest é o corpo de PUT /colaboradores/{id}, que substitui
+// todos os campos editáveis. Sem status, o atual é mantido; o desligamento tem
+// endpoint próprio.
+type ColaboradorUpdateRequest struct {
+	ColaboradorDados
+	Status string `json:"status" binding:"omitempty,oneof=ativo afastado desligado" example:"ativo"`
+}
+
+// newColaboradorUpdateRequest monta o corpo de PUT equivalente ao colaborador c.
+func newColaboradorUpdateRequest(c *models.Colaborador) ColaboradorUpdateRequest {
+	return ColaboradorUpdateRequest{
+		ColaboradorDados: ColaboradorDados{
+			Nome:           c.Nome,
+			CPF:            c.CPF,
+			RG:             c.RG,
+			Email:          c.Email,
+			EmailPessoal:   c.EmailPessoal,
+			Telefone:       c.Telefone,
+			Celular:        c.Celular,
+			DepartamentoID: c.DepartamentoID.String(),
+			CargoID:        uuidString(c.CargoID),
+			DataAdmissao:   c.DataAdmissao,
+		},
+		Status: c.Status,
+	}
+}
+
+func (d ColaboradorDados) toModel(status string) models.Colaborador {
+	return models.Colaborador{
+		Nome:           d.Nome,
+		CPF:            d.CPF,
+		RG:             d.RG,
+		Email:          d.Email,
+		EmailPessoal:   d.EmailPessoal,
+		Telefone:       d.Telefone,
+		Celular:        d.Celular,
+		DepartamentoID: uuid.MustParse(d.DepartamentoID),
+		CargoID:        optionalUUID(d.CargoID),
+		Status:         status,
+		DataAdmissao:   d.DataAdmissao,
+	}
+}
+
+// optionalUUID converte um id opcional já validado no binding; ausente ou vazio
+// vira nil.
+func optionalUUID(s *string) *uuid.UUID {
+	if s == nil || *s == "" {
+		return nil
+	}
+	id := uuid.MustParse(*s)
+	return &id
+}
+
+// uuidString é o inverso de optionalUUID.
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// ColaboradorResponse é a representação de um colaborador nas respostas da API.
+type ColaboradorResponse struct {
+	ID                 uuid.UUID  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome               string     `json:"nome" example:"João Silva"`
+	CPF                string     `json:"cpf" example:"529.982.247-25"`
+	RG                 *string    `json:"rg,omitempty" example:"MG1234567"`
+	Email              *string    `json:"email,omitempty" example:"joao.silva@empresa.com.br"`
+	EmailPessoal       *string    `json:"email_pessoal,omitempty" example:"joao@gmail.com"`
+	Telefone           *string    `json:"telefone,omitempty" example:"1133334444"`
+	Celular            *string    `json:"celular,omitempty" example:"11999998888"`
+	DepartamentoID     uuid.UUID  `json:"departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CargoID            *uuid.UUID `json:"cargo_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status             string     `json:"status" example:"ativo"`
+	DataAdmissao       *time.Time `json:"data_admissao,omitempty" example:"2024-03-01T00:00:00Z"`
+	DataDesligamento   *time.Time `json:"data_desligamento,omitempty"`
+	MotivoDesligamento *string    `json:"motivo_desligamento,omitempty"`
+	Version            int        `json:"version" example:"1"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	DeletedAt          *time.Time `json:"deleted_at,omitempty"`
+}
+
+// newColaboradorResponse monta a resposta com o CPF na forma dada por viewCPF
+// (ver cpfView).
+func newColaboradorResponse(c *models.Colaborador, viewCPF func(string) string) ColaboradorResponse {
+	r := ColaboradorResponse{
+		ID:                 c.ID,
+		Nome:               c.Nome,
+		CPF:                viewCPF(c.CPF),
+		RG:                 c.RG,
+		Email:              c.Email,
+		EmailPessoal:       c.EmailPessoal,
+		Telefone:           c.Telefone,
+		Celular:            c.Celular,
+		DepartamentoID:     c.DepartamentoID,
+		CargoID:            c.CargoID,
+		Status:             c.Status,
+		DataAdmissao:       c.DataAdmissao,
+		DataDesligamento:   c.DataDesligamento,
+		MotivoDesligamento: c.MotivoDesligamento,
+		Version:            c.Version,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+	if c.DeletedAt.Valid {
+		deletedAt := c.DeletedAt.Time
+		r.DeletedAt = &deletedAt
+	}
+	return r
+}
+
+func newColaboradorResponses(list []models.Colaborador, viewCPF func(string) string) []ColaboradorResponse {
+	out := make([]ColaboradorResponse, 0, len(list))
+	for i := range list {
+		out = append(out, newColaboradorResponse(&list[i], viewCPF))
+	}
+	return out
+}
+
+// TransferResponse é a resposta de POST /colaboradores/{id}/transfers.
+type TransferResponse struct {
+	Colaborador ColaboradorResponse       `json:"colaborador"`
+	Lotacao     models.ColaboradorLotacao `json:"lotacao"`
+	Agendada    bool                      `json:"agendada" example:"false"`
+}
+
+func newTransferResponse(r *services.TransferResult, viewCPF func(string) string) TransferResponse {
+	return TransferResponse{
+		Colaborador: newColaboradorResponse(r.Colaborador, viewCPF),
+		Lotacao:     r.Lotacao,
+		Agendada:    r.Agendada,
+	}
+}
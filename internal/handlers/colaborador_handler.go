@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
-	"github.com/danubiobwm/company-api/internal/models"
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
 	"github.com/danubiobwm/company-api/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -21,148 +24,576 @@ func (h *ColaboradorHandler) RegisterRoutes(rg *gin.RouterGroup) {
 	r := rg.Group("/colaboradores")
 	r.GET("", h.GetAll)
 	r.GET("/:id", h.GetByID)
+	r.GET("/:id/chain-of-command", h.ChainOfCommand)
+	r.GET("/:id/history", h.History)
 	r.POST("", h.Create)
 	r.PUT("/:id", h.Update)
+	r.PATCH("/:id", h.Patch)
 	r.DELETE("/:id", h.Delete)
+	r.POST("/:id/terminate", h.Terminate)
+	r.POST("/:id/rehire", h.Rehire)
+	r.POST("/:id/restore", h.Restore)
+	r.POST("/:id/transfers", h.Transfer)
+	r.DELETE("/:id/transfers/scheduled", h.CancelScheduledTransfer)
+}
+
+// ColaboradorListQuery representa os parâmetros aceitos na listagem de colaboradores.
+// Sem status, os desligados só aparecem com include_terminated=true.
+type ColaboradorListQuery struct {
+	ListQuery
+	Nome              string `form:"nome"`
+	CPF               string `form:"cpf"`
+	RG                string `form:"rg"`
+	Email             string `form:"email"`
+	DepartamentoID    string `form:"departamento_id" binding:"omitempty,uuid"`
+	CargoID           string `form:"cargo_id" binding:"omitempty,uuid"`
+	Status            string `form:"status" binding:"omitempty,oneof=ativo afastado desligado"`
+	IncludeTerminated bool   `form:"include_terminated"`
+}
+
+// TerminateRequest é o corpo aceito no desligamento. Datas no formato AAAA-MM-DD.
+type TerminateRequest struct {
+	DataDesligamento string `json:"data_desligamento" binding:"omitempty,datetime=2006-01-02" example:"2025-06-30"`
+	Motivo           string `json:"motivo" binding:"required" example:"pedido de demissão"`
+}
+
+// RehireRequest é o corpo aceito na readmissão. Datas no formato AAAA-MM-DD.
+type RehireRequest struct {
+	DataAdmissao string `json:"data_admissao" binding:"omitempty,datetime=2006-01-02" example:"2025-09-01"`
+}
+
+// TransferRequest é o corpo aceito na transferência. Sem data_efetiva a
+// transferência vale a partir de hoje; datas futuras ficam agendadas.
+type TransferRequest struct {
+	DepartamentoID string `json:"departamento_id" binding:"required,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DataEfetiva    string `json:"data_efetiva" binding:"omitempty,datetime=2006-01-02" example:"2025-11-01"`
+	Motivo         string `json:"motivo" example:"reestruturação da área"`
+}
+
+// HistoryQuery representa os parâmetros aceitos no histórico de lotações.
+type HistoryQuery struct {
+	AsOf string `form:"as_of" binding:"omitempty,datetime=2006-01-02"`
 }
 
 // GetAll godoc
 // @Summary List all colaboradores
-// @Description Get a paginated list of colaboradores with optional filtering
+// @Description Get a paginated list of colaboradores with optional filtering and sorting.
+// @Description Terminated colaboradores are left out unless status=desligado or include_terminated=true.
+// @Description With mode=cursor (or a cursor) the listing uses keyset pagination on (created_at, id) and returns next_cursor.
+// @Description CPF is returned formatted (529.982.247-25); when MASK_CPF is set, callers without X-Admin-Token get it masked (***.982.247-**).
 // @Tags colaboradores
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(100)
-// @Success 200 {object} map[string]interface{} "Lista de colaboradores e total"
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Param page query int false "Page number (offset mode)" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at). Cursor mode only accepts created_at"
+// @Param mode query string false "Pagination mode" Enums(offset, cursor)
+// @Param cursor query string false "Opaque cursor returned as next_cursor"
+// @Param with_total query bool false "Include total count in cursor mode" default(false)
+// @Param nome query string false "Filter by name (partial, case-insensitive)"
+// @Param cpf query string false "Filter by CPF, with or without punctuation"
+// @Param rg query string false "Filter by RG"
+// @Param email query string false "Filter by corporate or personal email"
+// @Param departamento_id query string false "Filter by departamento ID (UUID)"
+// @Param cargo_id query string false "Filter by cargo ID (UUID)"
+// @Param status query string false "Filter by employment status" Enums(ativo, afastado, desligado)
+// @Param include_terminated query bool false "Include terminated colaboradores when no status is given" default(false)
+// @Param include_deleted query bool false "Include soft-deleted colaboradores (requires X-Admin-Token)" default(false)
+// @Success 200 {object} pagination.Response[ColaboradorResponse]
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 403 {object} ErrorResponse "Acesso de administrador necessário"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/colaboradores [get]
 func (h *ColaboradorHandler) GetAll(c *gin.Context) {
-	filters := make(map[string]interface{})
-	colabs, total, err := h.service.List(filters, 1, 100)
+	var q ColaboradorListQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	withDeleted, ok := includeDeleted(c)
+	if !ok {
+		return
+	}
+
+	filters := map[string]interface{}{
+		"nome":               q.Nome,
+		"cpf":                q.CPF,
+		"rg":                 q.RG,
+		"email":              q.Email,
+		"departamento_id":    q.DepartamentoID,
+		"cargo_id":           q.CargoID,
+		"status":             q.Status,
+		"include_desligados": q.IncludeTerminated,
+		"include_deleted":    withDeleted,
+	}
+
+	if q.CursorMode() {
+		params, err := q.CursorParams()
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+		colabs, next, total, err := h.service.ListByCursor(filters, params)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusOK, pagination.NewCursorResponse(newColaboradorResponses(colabs, cpfView(c)), next, total, params, c.Request.URL))
+		return
+	}
+
+	params, err := q.OffsetParams(repositories.ColaboradorSortFields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{
-		"total":         total,
-		"colaboradores": colabs,
-	})
+	colabs, total, err := h.service.List(filters, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, pagination.NewResponse(newColaboradorResponses(colabs, cpfView(c)), total, params, c.Request.URL))
 }
 
 // GetByID godoc
 // @Summary Get colaborador by ID
 // @Description Get detailed information about a specific colaborador
+// @Description CPF is returned formatted (529.982.247-25); when MASK_CPF is set, callers without X-Admin-Token get it masked (***.982.247-**).
 // @Tags colaboradores
 // @Accept json
 // @Produce json
 // @Param id path string true "Colaborador ID (UUID)"
-// @Success 200 {object} models.Colaborador
-// @Failure 400 {object} map[string]string "ID inválido"
-// @Failure 404 {object} map[string]string "Colaborador não encontrado"
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Param include_deleted query bool false "Also return a soft-deleted colaborador (requires X-Admin-Token)" default(false)
+// @Param If-None-Match header string false "ETag from a previous response; 304 is returned when it still matches"
+// @Success 200 {object} ColaboradorResponse
+// @Header 200 {string} ETag "Current version of the colaborador"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 403 {object} ErrorResponse "Acesso de administrador necessário"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/colaboradores/{id} [get]
 func (h *ColaboradorHandler) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	withDeleted, ok := includeDeleted(c)
+	if !ok {
 		return
 	}
 
-	colab, err := h.service.GetByID(id)
+	get := h.service.GetByID
+	if withDeleted {
+		get = h.service.GetByIDUnscoped
+	}
+	colab, err := get(id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 	if colab == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "colaborador não encontrado"})
+		writeProblem(c, http.StatusNotFound, dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+		return
+	}
+	if notModified(c, colab.Version) {
+		return
+	}
+	setETag(c, colab.Version)
+	c.JSON(http.StatusOK, newColaboradorResponse(colab, cpfView(c)))
+}
+
+// ChainOfCommand godoc
+// @Summary Get a colaborador's chain of command
+// @Description Walk departamento_superior_id upward from the colaborador's departamento and return each departamento with its gerente, nearest first
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Success 200 {object} services.ChainOfCommand
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/chain-of-command [get]
+func (h *ColaboradorHandler) ChainOfCommand(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	chain, err := h.service.ChainOfCommand(id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if chain == nil {
+		writeProblem(c, http.StatusNotFound, dderr.CodeColaboradorNotFound, "colaborador não encontrado")
 		return
 	}
-	c.JSON(http.StatusOK, colab)
+	c.JSON(http.StatusOK, chain)
 }
 
 // Create godoc
 // @Summary Create a new colaborador
 // @Description Create a new colaborador with the provided data
+// @Description CPF is accepted with or without punctuation and stored as digits only.
 // @Tags colaboradores
 // @Accept json
 // @Produce json
-// @Param colaborador body models.Colaborador true "Colaborador data"
-// @Success 201 {object} models.Colaborador
-// @Failure 400 {object} map[string]string "Erro de validação"
-// @Failure 422 {object} map[string]string "Entidade não processável"
+// @Param colaborador body ColaboradorCreateRequest true "Colaborador data"
+// @Success 201 {object} ColaboradorResponse
+// @Header 201 {string} ETag "Version of the created colaborador"
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 409 {object} ErrorResponse "CPF, RG ou e-mail já cadastrado"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
 // @Router /api/v1/colaboradores [post]
 func (h *ColaboradorHandler) Create(c *gin.Context) {
-	var colab models.Colaborador
-	if err := c.ShouldBindJSON(&colab); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req ColaboradorCreateRequest
+	if !bindJSON(c, &req) {
 		return
 	}
+	colab := req.toModel(req.Status)
 
-	if err := h.service.Create(&colab); err != nil {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	if err := h.service.Create(c.Request.Context(), &colab); err != nil {
+		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, colab)
+	setETag(c, colab.Version)
+	c.JSON(http.StatusCreated, newColaboradorResponse(&colab, cpfView(c)))
 }
 
 // Update godoc
 // @Summary Update a colaborador
-// @Description Update an existing colaborador by ID
+// @Description Update an existing colaborador by ID.
+// @Description Send the ETag from GET in If-Match to update only if nobody changed the colaborador since it was read; a stale ETag returns 412.
 // @Tags colaboradores
 // @Accept json
 // @Produce json
 // @Param id path string true "Colaborador ID (UUID)"
-// @Param colaborador body models.Colaborador true "Colaborador data"
-// @Success 200 {object} models.Colaborador
-// @Failure 400 {object} map[string]string "Erro de validação"
-// @Failure 422 {object} map[string]string "Entidade não processável"
+// @Param If-Match header string false "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)"
+// @Param colaborador body ColaboradorUpdateRequest true "Colaborador data"
+// @Success 200 {object} ColaboradorResponse
+// @Header 200 {string} ETag "New version of the colaborador"
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 409 {object} ErrorResponse "CPF, RG ou e-mail já cadastrado, ou alterado por outra requisição durante a atualização"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
 // @Router /api/v1/colaboradores/{id} [put]
 func (h *ColaboradorHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
 		return
 	}
 
-	var colab models.Colaborador
-	if err := c.ShouldBindJSON(&colab); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req ColaboradorUpdateRequest
+	if !bindJSON(c, &req) {
 		return
 	}
+	colab := req.toModel(req.Status)
 	colab.ID = id
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+	colab.Version = version
+
+	if err := h.service.Update(c.Request.Context(), &colab); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	setETag(c, colab.Version)
+	c.JSON(http.StatusOK, newColaboradorResponse(&colab, cpfView(c)))
+}
+
+// Patch godoc
+// @Summary Partially update a colaborador
+// @Description Apply a JSON Merge Patch (RFC 7396): fields present in the body are replaced, fields set to null are cleared and absent fields are kept.
+// @Description Accepted fields: nome, cpf, rg, email, email_pessoal, telefone, celular, departamento_id, cargo_id, status and data_admissao. Only rg, email, email_pessoal, telefone, celular, cargo_id and data_admissao accept null.
+// @Description Changing departamento_id records a transfer effective today, as in PUT. Termination fields are changed through terminate and rehire.
+// @Tags colaboradores
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Param If-Match header string false "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)"
+// @Param patch body object true "Merge patch"
+// @Success 200 {object} ColaboradorResponse
+// @Header 200 {string} ETag "New version of the colaborador"
+// @Failure 400 {object} ErrorResponse "Patch inválido"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 409 {object} ErrorResponse "Colaborador desligado ou alterado durante a atualização"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 415 {object} ErrorResponse "Content-Type não suportado"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
+// @Router /api/v1/colaboradores/{id} [patch]
+func (h *ColaboradorHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+	patch, ok := readMergePatch(c)
+	if !ok {
+		return
+	}
 
-	if err := h.service.Update(&colab); err != nil {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	colab, err := h.service.Patch(c.Request.Context(), id, version, patch, validateColaborador)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, colab)
+	setETag(c, colab.Version)
+	c.JSON(http.StatusOK, newColaboradorResponse(colab, cpfView(c)))
 }
 
 // Delete godoc
 // @Summary Delete a colaborador
-// @Description Delete a colaborador by ID
+// @Description Soft delete a colaborador by ID. It can be restored until the purge job removes it.
+// @Description With If-Match the deletion only happens if the colaborador is still at that version; otherwise 412 is returned.
 // @Tags colaboradores
 // @Accept json
 // @Produce json
 // @Param id path string true "Colaborador ID (UUID)"
+// @Param If-Match header string false "ETag of the version being deleted (required when REQUIRE_IF_MATCH is set)"
 // @Success 204 "No Content"
-// @Failure 400 {object} map[string]string "ID inválido"
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/colaboradores/{id} [delete]
 func (h *ColaboradorHandler) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
 		return
 	}
 
-	if err := h.service.Delete(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id, version); err != nil {
+		_ = c.Error(err)
 		return
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// Terminate godoc
+// @Summary Terminate a colaborador
+// @Description Mark the colaborador as terminated (desligado) with date and reason. The record is kept and stays retrievable by ID
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Param desligamento body TerminateRequest true "Termination data"
+// @Success 200 {object} ColaboradorResponse
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 409 {object} ErrorResponse "Colaborador já desligado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/terminate [post]
+func (h *ColaboradorHandler) Terminate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	var req TerminateRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	colab, err := h.service.Terminate(c.Request.Context(), id, parseDate(req.DataDesligamento), req.Motivo)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newColaboradorResponse(colab, cpfView(c)))
+}
+
+// Rehire godoc
+// @Summary Rehire a terminated colaborador
+// @Description Reactivate a terminated colaborador with a new admission date (today if omitted)
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Param readmissao body RehireRequest false "Rehire data"
+// @Success 200 {object} ColaboradorResponse
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 409 {object} ErrorResponse "Colaborador não está desligado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/rehire [post]
+func (h *ColaboradorHandler) Rehire(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	var req RehireRequest
+	if c.Request.ContentLength != 0 {
+		if !bindJSON(c, &req) {
+			return
+		}
+	}
+
+	colab, err := h.service.Rehire(c.Request.Context(), id, parseDate(req.DataAdmissao))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newColaboradorResponse(colab, cpfView(c)))
+}
+
+// Restore godoc
+// @Summary Restore a deleted colaborador
+// @Description Undo the soft delete of a colaborador. Its departamento must still be active
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Success 200 {object} ColaboradorResponse
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 409 {object} ErrorResponse "Colaborador não está excluído ou departamento excluído"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/restore [post]
+func (h *ColaboradorHandler) Restore(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	colab, err := h.service.Restore(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newColaboradorResponse(colab, cpfView(c)))
+}
+
+// History godoc
+// @Summary Get a colaborador's department history
+// @Description List every department assignment of the colaborador with valid_from/valid_to (valid_to is exclusive), including scheduled transfers.
+// @Description With as_of only the assignment in effect on that date is returned
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Param as_of query string false "Date (YYYY-MM-DD) to look up"
+// @Success 200 {object} services.ColaboradorHistory
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/history [get]
+func (h *ColaboradorHandler) History(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	var q HistoryQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	history, err := h.service.History(id, parseDate(q.AsOf))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if history == nil {
+		writeProblem(c, http.StatusNotFound, dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+		return
+	}
+	c.JSON(http.StatusOK, history)
+}
+
+// Transfer godoc
+// @Summary Transfer a colaborador to another departamento
+// @Description Move the colaborador to the departamento from data_efetiva (today if omitted). Future dates are scheduled and applied by the transfer job; a new transfer replaces any scheduled one
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Param transferencia body TransferRequest true "Transfer data"
+// @Success 200 {object} TransferResponse
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 404 {object} ErrorResponse "Colaborador não encontrado"
+// @Failure 409 {object} ErrorResponse "Colaborador desligado"
+// @Failure 422 {object} ErrorResponse "Transferência inválida"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/transfers [post]
+func (h *ColaboradorHandler) Transfer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	var req TransferRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.service.Transfer(c.Request.Context(), id, uuid.MustParse(req.DepartamentoID), parseDate(req.DataEfetiva), req.Motivo)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newTransferResponse(result, cpfView(c)))
+}
+
+// CancelScheduledTransfer godoc
+// @Summary Cancel a scheduled transfer
+// @Description Remove the colaborador's pending future transfer and reopen the current assignment
+// @Tags colaboradores
+// @Accept json
+// @Produce json
+// @Param id path string true "Colaborador ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Nenhuma transferência agendada"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/colaboradores/{id}/transfers/scheduled [delete]
+func (h *ColaboradorHandler) CancelScheduledTransfer(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	if err := h.service.CancelScheduledTransfer(c.Request.Context(), id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// parseDate converte uma data AAAA-MM-DD já validada no binding; vazio vira nil.
+func parseDate(v string) *time.Time {
+	if v == "" {
+		return nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/google/uuid"
+)
+
+// DepartamentoRequest é o corpo de POST /departamentos e de PUT
+// /departamentos/{id}, que substitui todos os campos editáveis.
+type DepartamentoRequest struct {
+	Nome                   string  `json:"nome" binding:"required,max=100" example:"Tecnologia da Informação"`
+	Descricao              *string `json:"descricao" example:"Infraestrutura e sistemas"`
+	GerenteID              *string `json:"gerente_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DepartamentoSuperiorID *string `json:"departamento_superior_id" binding:"omitempty,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+// newDepartamentoRequest monta o corpo de PUT equivalente ao departamento d.
+func newDepartamentoRequest(d *models.Departamento) DepartamentoRequest {
+	return DepartamentoRequest{
+		Nome:                   d.Nome,
+		Descricao:              d.Descricao,
+		GerenteID:              uuidString(d.GerenteID),
+		DepartamentoSuperiorID: uuidString(d.DepartamentoSuperiorID),
+	}
+}
+
+func (r DepartamentoRequest) toModel() models.Departamento {
+	return models.Departamento{
+		Nome:                   r.Nome,
+		Descricao:              r.Descricao,
+		GerenteID:              optionalUUID(r.GerenteID),
+		DepartamentoSuperiorID: optionalUUID(r.DepartamentoSuperiorID),
+	}
+}
+
+// DepartamentoResponse é a representação de um departamento nas respostas da API.
+type DepartamentoResponse struct {
+	ID                     uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome                   string               `json:"nome" example:"Tecnologia da Informação"`
+	Descricao              *string              `json:"descricao,omitempty" example:"Infraestrutura e sistemas"`
+	GerenteID              *uuid.UUID           `json:"gerente_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DepartamentoSuperiorID *uuid.UUID           `json:"departamento_superior_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Version                int                  `json:"version" example:"1"`
+	CreatedAt              time.Time            `json:"created_at"`
+	UpdatedAt              time.Time            `json:"updated_at"`
+	DeletedAt              *time.Time           `json:"deleted_at,omitempty"`
+	Gerente                *ColaboradorResponse `json:"gerente,omitempty"`
+}
+
+func newDepartamentoResponse(d *models.Departamento, viewCPF func(string) string) DepartamentoResponse {
+	r := DepartamentoResponse{
+		ID:                     d.ID,
+		Nome:                   d.Nome,
+		Descricao:              d.Descricao,
+		GerenteID:              d.GerenteID,
+		DepartamentoSuperiorID: d.DepartamentoSuperiorID,
+		Version:                d.Version,
+		CreatedAt:              d.CreatedAt,
+		UpdatedAt:              d.UpdatedAt,
+	}
+	if d.DeletedAt.Valid {
+		deletedAt := d.DeletedAt.Time
+		r.DeletedAt = &deletedAt
+	}
+	if d.Gerente != nil {
+		gerente := newColaboradorResponse(d.Gerente, viewCPF)
+		r.Gerente = &gerente
+	}
+	return r
+}
+
+func newDepartamentoResponses(list []models.Departamento, viewCPF func(string) string) []DepartamentoResponse {
+	out := make([]DepartamentoResponse, 0, len(list))
+	for i := range list {
+		out = append(out, newDepartamentoResponse(&list[i], viewCPF))
+	}
+	return out
+}
+
+// MergeResponse é a resposta de POST /departamentos/{id}/merge-into/{target}.
+type MergeResponse struct {
+	Departamento            DepartamentoResponse `json:"departamento"`
+	ColaboradoresMovidos    int64                `json:"colaboradores_movidos" example:"12"`
+	SubdepartamentosMovidos int64                `json:"subdepartamentos_movidos" example:"2"`
+}
+
+func newMergeResponse(r *services.MergeResult, viewCPF func(string) string) MergeResponse {
+	return MergeResponse{
+		Departamento:            newDepartamentoResponse(r.Departamento, viewCPF),
+		ColaboradoresMovidos:    r.ColaboradoresMovidos,
+		SubdepartamentosMovidos: r.SubdepartamentosMovidos,
+	}
+}
+
+// DepartamentoTreeNode é um nó da árvore organizacional nas respostas da API.
+// HeadcountDireto conta os colaboradores do próprio departamento e HeadcountTotal
+// inclui todos os subdepartamentos, mesmo os cortados pelo limite de profundidade.
+type DepartamentoTreeNode struct {
+	ID                     uuid.UUID                `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome                   string                   `json:"nome" example:"Tecnologia da Informação"`
+	Descricao              *string                  `json:"descricao,omitempty"`
+	DepartamentoSuperiorID *uuid.UUID               `json:"departamento_superior_id,omitempty"`
+	Gerente                *services.GerenteSummary `json:"gerente,omitempty"`
+	HeadcountDireto        int64                    `json:"headcount_direto" example:"4"`
+	HeadcountTotal         int64                    `json:"headcount_total" example:"12"`
+	Colaboradores          []TreeColaborador        `json:"colaboradores,omitempty"`
+	Subdepartamentos       []DepartamentoTreeNode   `json:"subdepartamentos"`
+}
+
+// TreeColaborador é a forma resumida do colaborador listado em um nó da árvore.
+type TreeColaborador struct {
+	ID      uuid.UUID  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome    string     `json:"nome" example:"João Silva"`
+	CPF     string     `json:"cpf" example:"529.982.247-25"`
+	Email   *string    `json:"email,omitempty" example:"joao.silva@empresa.com.br"`
+	CargoID *uuid.UUID `json:"cargo_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status  string     `json:"status" example:"ativo"`
+}
+
+func newDepartamentoTreeNode(n *services.DepartamentoNode, viewCPF func(string) string) DepartamentoTreeNode {
+	r := DepartamentoTreeNode{
+		ID:                     n.ID,
+		Nome:                   n.Nome,
+		Descricao:              n.Descricao,
+		DepartamentoSuperiorID: n.DepartamentoSuperiorID,
+		Gerente:                n.Gerente,
+		HeadcountDireto:        n.HeadcountDireto,
+		HeadcountTotal:         n.HeadcountTotal,
+		Subdepartamentos:       newDepartamentoTreeNodes(n.Subdepartamentos, viewCPF),
+	}
+	for _, c := range n.Colaboradores {
+		r.Colaboradores = append(r.Colaboradores, TreeColaborador{
+			ID:      c.ID,
+			Nome:    c.Nome,
+			CPF:     viewCPF(c.CPF),
+			Email:   c.Email,
+			CargoID: c.CargoID,
+			Status:  c.Status,
+		})
+	}
+	return r
+}
+
+func newDepartamentoTreeNodes(nodes []*services.DepartamentoNode, viewCPF func(string) string) []DepartamentoTreeNode {
+	out := make([]DepartamentoTreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, newDepartamentoTreeNode(n, viewCPF))
+	}
+	return out
+}
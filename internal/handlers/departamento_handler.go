@@ -3,7 +3,9 @@ package handlers
 import (
 	"net/http"
 
-	"github.com/danubiobwm/company-api/internal/models"
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
 	"github.com/danubiobwm/company-api/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -20,28 +22,218 @@ func NewDepartamentoHandler(s *services.DepartamentoService) *DepartamentoHandle
 func (h *DepartamentoHandler) RegisterRoutes(rg *gin.RouterGroup) {
 	r := rg.Group("/departamentos")
 	r.GET("", h.GetAll)
+	r.GET("/tree", h.Tree)
 	r.GET("/:id", h.GetByID)
+	r.GET("/:id/tree", h.SubTree)
+	r.GET("/:id/ancestors", h.Ancestors)
 	r.POST("", h.Create)
 	r.PUT("/:id", h.Update)
+	r.PATCH("/:id", h.Patch)
 	r.DELETE("/:id", h.Delete)
+	r.POST("/:id/move", h.Move)
+	r.POST("/:id/merge-into/:target", h.MergeInto)
+	r.POST("/:id/restore", h.Restore)
+}
+
+// DepartamentoListQuery representa os parâmetros aceitos na listagem de departamentos.
+type DepartamentoListQuery struct {
+	ListQuery
+	Nome                   string `form:"nome"`
+	GerenteID              string `form:"gerente_id" binding:"omitempty,uuid"`
+	DepartamentoSuperiorID string `form:"departamento_superior_id" binding:"omitempty,uuid"`
+	Root                   bool   `form:"root"`
+	Include                string `form:"include"`
 }
 
 // GetAll godoc
 // @Summary List all departamentos
-// @Description Get a list of all departamentos
+// @Description Get a paginated list of departamentos with optional filtering and sorting.
+// @Description With mode=cursor (or a cursor) the listing uses keyset pagination on (created_at, id) and returns next_cursor.
 // @Tags departamentos
 // @Accept json
 // @Produce json
-// @Success 200 {array} models.Departamento
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Param page query int false "Page number (offset mode)" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at). Cursor mode only accepts created_at"
+// @Param mode query string false "Pagination mode" Enums(offset, cursor)
+// @Param cursor query string false "Opaque cursor returned as next_cursor"
+// @Param with_total query bool false "Include total count in cursor mode" default(false)
+// @Param nome query string false "Filter by name (partial, case-insensitive)"
+// @Param gerente_id query string false "Filter by gerente ID (UUID)"
+// @Param departamento_superior_id query string false "Filter by parent departamento ID (UUID)"
+// @Param root query bool false "Only departamentos without a parent"
+// @Param include query string false "Related data to load" Enums(gerente)
+// @Param include_deleted query bool false "Include soft-deleted departamentos (requires X-Admin-Token)" default(false)
+// @Success 200 {object} pagination.Response[DepartamentoResponse]
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 403 {object} ErrorResponse "Acesso de administrador necessário"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/departamentos [get]
 func (h *DepartamentoHandler) GetAll(c *gin.Context) {
-	depts, err := h.service.GetAll()
+	var q DepartamentoListQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	include, err := parseInclude(q.Include, "gerente")
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
+		return
+	}
+	withDeleted, ok := includeDeleted(c)
+	if !ok {
 		return
 	}
-	c.JSON(http.StatusOK, depts)
+
+	filters := map[string]interface{}{
+		"nome":                     q.Nome,
+		"gerente_id":               q.GerenteID,
+		"departamento_superior_id": q.DepartamentoSuperiorID,
+		"root":                     q.Root,
+		"include_deleted":          withDeleted,
+	}
+
+	if q.CursorMode() {
+		params, err := q.CursorParams()
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+		depts, next, total, err := h.service.ListByCursor(filters, params, include["gerente"])
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusOK, pagination.NewCursorResponse(newDepartamentoResponses(depts, cpfView(c)), next, total, params, c.Request.URL))
+		return
+	}
+
+	params, err := q.OffsetParams(repositories.DepartamentoSortFields)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	depts, total, err := h.service.List(filters, params, include["gerente"])
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, pagination.NewResponse(newDepartamentoResponses(depts, cpfView(c)), total, params, c.Request.URL))
+}
+
+// DepartamentoTreeQuery representa os parâmetros aceitos nos endpoints de árvore.
+// Com as_of (AAAA-MM-DD) a árvore é reconstruída como estava naquela data.
+type DepartamentoTreeQuery struct {
+	Depth   int    `form:"depth" binding:"omitempty,min=1"`
+	Include string `form:"include"`
+	AsOf    string `form:"as_of" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// Tree godoc
+// @Summary Get the organisational tree
+// @Description Get every departamento as a nested tree built from departamento_superior_id, with gerente summary and direct/total headcount per node.
+// @Description With as_of the tree is rebuilt as it was at the end of that date from departamento versions and colaborador assignment history.
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param depth query int false "Maximum number of levels to return"
+// @Param include query string false "Related data to load" Enums(colaboradores)
+// @Param as_of query string false "Date (YYYY-MM-DD) to rebuild the tree for"
+// @Success 200 {array} DepartamentoTreeNode
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/tree [get]
+func (h *DepartamentoHandler) Tree(c *gin.Context) {
+	var q DepartamentoTreeQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	include, err := parseInclude(q.Include, "colaboradores")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	roots, err := h.service.Tree(nil, q.Depth, include["colaboradores"], parseDate(q.AsOf))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newDepartamentoTreeNodes(roots, cpfView(c)))
+}
+
+// SubTree godoc
+// @Summary Get the tree below a departamento
+// @Description Get a departamento and all its sub-departamentos as a nested tree, with gerente summary and direct/total headcount per node.
+// @Description With as_of the subtree is rebuilt as it was at the end of that date.
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Param depth query int false "Maximum number of levels to return"
+// @Param include query string false "Related data to load" Enums(colaboradores)
+// @Param as_of query string false "Date (YYYY-MM-DD) to rebuild the tree for"
+// @Success 200 {object} DepartamentoTreeNode
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/tree [get]
+func (h *DepartamentoHandler) SubTree(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	var q DepartamentoTreeQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	include, err := parseInclude(q.Include, "colaboradores")
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	roots, err := h.service.Tree(&id, q.Depth, include["colaboradores"], parseDate(q.AsOf))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if len(roots) == 0 {
+		writeProblem(c, http.StatusNotFound, dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		return
+	}
+	c.JSON(http.StatusOK, newDepartamentoTreeNode(roots[0], cpfView(c)))
+}
+
+// Ancestors godoc
+// @Summary Get the path from the root to a departamento
+// @Description Walk departamento_superior_id upward and return the ordered path (root first, departamento itself last) with each departamento's gerente
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Success 200 {array} services.DepartamentoPathItem
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/ancestors [get]
+func (h *DepartamentoHandler) Ancestors(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	path, err := h.service.Ancestors(id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if len(path) == 0 {
+		writeProblem(c, http.StatusNotFound, dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		return
+	}
+	c.JSON(http.StatusOK, path)
 }
 
 // GetByID godoc
@@ -51,29 +243,46 @@ func (h *DepartamentoHandler) GetAll(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Departamento ID (UUID)"
-// @Success 200 {object} models.Departamento
-// @Failure 400 {object} map[string]string "ID inválido"
-// @Failure 404 {object} map[string]string "Departamento não encontrado"
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Param include_deleted query bool false "Also return a soft-deleted departamento (requires X-Admin-Token)" default(false)
+// @Param If-None-Match header string false "ETag from a previous response; 304 is returned when it still matches"
+// @Success 200 {object} DepartamentoResponse
+// @Header 200 {string} ETag "Current version of the departamento"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 403 {object} ErrorResponse "Acesso de administrador necessário"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/departamentos/{id} [get]
 func (h *DepartamentoHandler) GetByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	withDeleted, ok := includeDeleted(c)
+	if !ok {
 		return
 	}
 
-	dept, err := h.service.GetByID(id)
+	get := h.service.GetByID
+	if withDeleted {
+		get = h.service.GetByIDUnscoped
+	}
+	dept, err := get(id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 	if dept == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "departamento não encontrado"})
+		writeProblem(c, http.StatusNotFound, dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		return
+	}
+	if notModified(c, dept.Version) {
 		return
 	}
-	c.JSON(http.StatusOK, dept)
+	setETag(c, dept.Version)
+	c.JSON(http.StatusOK, newDepartamentoResponse(dept, cpfView(c)))
 }
 
 // Create godoc
@@ -82,81 +291,269 @@ func (h *DepartamentoHandler) GetByID(c *gin.Context) {
 // @Tags departamentos
 // @Accept json
 // @Produce json
-// @Param departamento body models.Departamento true "Departamento data"
-// @Success 201 {object} models.Departamento
-// @Failure 400 {object} map[string]string "Erro de validação"
-// @Failure 422 {object} map[string]string "Entidade não processável"
+// @Param departamento body DepartamentoRequest true "Departamento data"
+// @Success 201 {object} DepartamentoResponse
+// @Header 201 {string} ETag "Version of the created departamento"
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
 // @Router /api/v1/departamentos [post]
 func (h *DepartamentoHandler) Create(c *gin.Context) {
-	var dept models.Departamento
-	if err := c.ShouldBindJSON(&dept); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req DepartamentoRequest
+	if !bindJSON(c, &req) {
 		return
 	}
+	dept := req.toModel()
 
-	if err := h.service.Create(&dept); err != nil {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	if err := h.service.Create(c.Request.Context(), &dept); err != nil {
+		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusCreated, dept)
+	setETag(c, dept.Version)
+	c.JSON(http.StatusCreated, newDepartamentoResponse(&dept, cpfView(c)))
 }
 
 // Update godoc
 // @Summary Update a departamento
-// @Description Update an existing departamento by ID
+// @Description Update an existing departamento by ID.
+// @Description Send the ETag from GET in If-Match to update only if nobody changed the departamento since it was read; a stale ETag returns 412.
 // @Tags departamentos
 // @Accept json
 // @Produce json
 // @Param id path string true "Departamento ID (UUID)"
-// @Param departamento body models.Departamento true "Departamento data"
-// @Success 200 {object} models.Departamento
-// @Failure 400 {object} map[string]string "Erro de validação"
-// @Failure 422 {object} map[string]string "Entidade não processável"
+// @Param If-Match header string false "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)"
+// @Param departamento body DepartamentoRequest true "Departamento data"
+// @Success 200 {object} DepartamentoResponse
+// @Header 200 {string} ETag "New version of the departamento"
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 409 {object} ErrorResponse "Alterado por outra requisição durante a atualização"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
 // @Router /api/v1/departamentos/{id} [put]
 func (h *DepartamentoHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
 		return
 	}
 
-	var dept models.Departamento
-	if err := c.ShouldBindJSON(&dept); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var req DepartamentoRequest
+	if !bindJSON(c, &req) {
 		return
 	}
+	dept := req.toModel()
 	dept.ID = id
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+	dept.Version = version
+
+	if err := h.service.Update(c.Request.Context(), &dept); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	setETag(c, dept.Version)
+	c.JSON(http.StatusOK, newDepartamentoResponse(&dept, cpfView(c)))
+}
 
-	if err := h.service.Update(&dept); err != nil {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+// Patch godoc
+// @Summary Partially update a departamento
+// @Description Apply a JSON Merge Patch (RFC 7396): fields present in the body are replaced, fields set to null are cleared and absent fields are kept.
+// @Description Accepted fields: nome, descricao, gerente_id and departamento_superior_id; all but nome accept null. A new departamento_superior_id is checked for cycles and maximum depth, as in PUT.
+// @Tags departamentos
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Param If-Match header string false "ETag of the version being updated (required when REQUIRE_IF_MATCH is set)"
+// @Param patch body object true "Merge patch"
+// @Success 200 {object} DepartamentoResponse
+// @Header 200 {string} ETag "New version of the departamento"
+// @Failure 400 {object} ErrorResponse "Patch inválido"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 409 {object} ErrorResponse "Alterado por outra requisição durante a atualização"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 415 {object} ErrorResponse "Content-Type não suportado"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
+// @Router /api/v1/departamentos/{id} [patch]
+func (h *DepartamentoHandler) Patch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+	patch, ok := readMergePatch(c)
+	if !ok {
+		return
+	}
+
+	dept, err := h.service.Patch(c.Request.Context(), id, version, patch, validateDepartamento)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, dept)
+	setETag(c, dept.Version)
+	c.JSON(http.StatusOK, newDepartamentoResponse(dept, cpfView(c)))
+}
+
+// DepartamentoDeleteQuery representa os parâmetros aceitos na exclusão de departamentos.
+type DepartamentoDeleteQuery struct {
+	Strategy string `form:"strategy" binding:"omitempty,oneof=restrict reassign cascade"`
+	Target   string `form:"target" binding:"omitempty,uuid"`
 }
 
 // Delete godoc
 // @Summary Delete a departamento
-// @Description Delete a departamento by ID
+// @Description Delete a departamento by ID. By default (strategy=restrict) the deletion is refused with 409 when the departamento still has colaboradores or sub-departamentos.
+// @Description strategy=reassign moves them to target before deleting; strategy=cascade deletes the whole subtree and its colaboradores.
+// @Description Deletions are soft: rows get deleted_at and can be restored until the purge job removes them.
+// @Description With If-Match the deletion only happens if the departamento is still at that version; otherwise 412 is returned.
 // @Tags departamentos
 // @Accept json
 // @Produce json
 // @Param id path string true "Departamento ID (UUID)"
+// @Param If-Match header string false "ETag of the version being deleted (required when REQUIRE_IF_MATCH is set)"
+// @Param strategy query string false "Deletion strategy" Enums(restrict, reassign, cascade) default(restrict)
+// @Param target query string false "Target departamento ID (UUID), required with strategy=reassign"
 // @Success 204 "No Content"
-// @Failure 400 {object} map[string]string "ID inválido"
-// @Failure 500 {object} map[string]string "Erro interno"
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 409 {object} ErrorResponse "Departamento possui colaboradores ou subdepartamentos"
+// @Failure 412 {object} ErrorResponse "If-Match não corresponde à versão atual"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Failure 428 {object} ErrorResponse "If-Match obrigatório"
+// @Failure 500 {object} ErrorResponse "Erro interno"
 // @Router /api/v1/departamentos/{id} [delete]
 func (h *DepartamentoHandler) Delete(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
+		writeError(c, invalidParameter("id", "uuid", ""))
 		return
 	}
 
-	if err := h.service.Delete(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	var q DepartamentoDeleteQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	version, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+	opts := services.DeleteOptions{Strategy: q.Strategy, Version: version}
+	if q.Target != "" {
+		target := uuid.MustParse(q.Target)
+		opts.TargetID = &target
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id, opts); err != nil {
+		_ = c.Error(err)
 		return
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// MoveDepartamentoRequest é o corpo de POST /departamentos/{id}/move.
+// departamento_superior_id nulo (ou ausente) torna o departamento uma raiz.
+type MoveDepartamentoRequest struct {
+	DepartamentoSuperiorID *uuid.UUID `json:"departamento_superior_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+// Move godoc
+// @Summary Move a departamento subtree
+// @Description Re-parent a departamento together with its whole subtree in a single transaction, rejecting cycles and hierarchies deeper than the configured maximum
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Param body body MoveDepartamentoRequest true "New parent (null for root)"
+// @Success 200 {object} DepartamentoResponse
+// @Failure 400 {object} ErrorResponse "Erro de validação"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Router /api/v1/departamentos/{id}/move [post]
+func (h *DepartamentoHandler) Move(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	var req MoveDepartamentoRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	dept, err := h.service.Move(c.Request.Context(), id, req.DepartamentoSuperiorID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newDepartamentoResponse(dept, cpfView(c)))
+}
+
+// MergeInto godoc
+// @Summary Merge a departamento into another
+// @Description Reassign every colaborador and child departamento of the source to the target and delete the source, in a single transaction
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "Source departamento ID (UUID)"
+// @Param target path string true "Target departamento ID (UUID)"
+// @Success 200 {object} MergeResponse
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 422 {object} ErrorResponse "Entidade não processável"
+// @Router /api/v1/departamentos/{id}/merge-into/{target} [post]
+func (h *DepartamentoHandler) MergeInto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+	target, err := uuid.Parse(c.Param("target"))
+	if err != nil {
+		writeError(c, invalidParameter("target", "uuid", ""))
+		return
+	}
+
+	result, err := h.service.MergeInto(c.Request.Context(), id, target)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newMergeResponse(result, cpfView(c)))
+}
+
+// Restore godoc
+// @Summary Restore a deleted departamento
+// @Description Undo the soft delete of a departamento. Its parent must still be active and the hierarchy rules of a move apply. Colaboradores and subdepartamentos deleted with it are not restored
+// @Tags departamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "Departamento ID (UUID)"
+// @Success 200 {object} DepartamentoResponse
+// @Failure 400 {object} ErrorResponse "ID inválido"
+// @Failure 404 {object} ErrorResponse "Departamento não encontrado"
+// @Failure 409 {object} ErrorResponse "Departamento não está excluído"
+// @Failure 422 {object} ErrorResponse "Hierarquia inválida"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/departamentos/{id}/restore [post]
+func (h *DepartamentoHandler) Restore(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
+
+	dept, err := h.service.Restore(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, newDepartamentoResponse(dept, cpfView(c)))
+}
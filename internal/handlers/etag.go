@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const requireIfMatchContextKey = "require_if_match"
+
+// Preconditions define se PUT, PATCH e DELETE de recursos versionados exigem o
+// cabeçalho If-Match. Sem a exigência, o cabeçalho continua sendo respeitado
+// quando enviado.
+func Preconditions(requireIfMatch bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(requireIfMatchContextKey, requireIfMatch)
+		c.Next()
+	}
+}
+
+// etag é a ETag forte de um registro na versão informada.
+func etag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", etag(version))
+}
+
+// notModified responde 304 quando alguma ETag de If-None-Match corresponde à
+// versão atual (comparação fraca, como pede a RFC 9110). Retorna true quando a
+// resposta já foi escrita.
+func notModified(c *gin.Context, version int) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" {
+		return false
+	}
+	current := etag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == current {
+			setETag(c, version)
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion lê a versão esperada em If-Match. Versão 0 significa sem
+// pré-condição (cabeçalho ausente ou "*"). Quando retorna ok=false a resposta de
+// erro já foi escrita: 428 se o cabeçalho é exigido e não veio, 412 se a ETag
+// não pode corresponder a nenhuma versão.
+func ifMatchVersion(c *gin.Context) (version int, ok bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		if c.GetBool(requireIfMatchContextKey) {
+			writeProblem(c, http.StatusPreconditionRequired, dderr.CodePreconditionRequired, "cabeçalho If-Match obrigatório")
+			return 0, false
+		}
+		return 0, true
+	}
+	if header == "*" {
+		return 0, true
+	}
+	if strings.Contains(header, ",") {
+		writeError(c, invalidParameter("If-Match", "single", ""))
+		return 0, false
+	}
+	// If-Match usa comparação forte: ETag fraca nunca corresponde
+	raw, err := strconv.Unquote(header)
+	if err == nil {
+		version, err = strconv.Atoi(raw)
+	}
+	if err != nil || version < 1 || strings.HasPrefix(header, "W/") {
+		writeKeyedProblem(c, http.StatusPreconditionFailed, dderr.CodeVersionConflict, "versao.if_match", "If-Match não corresponde à versão atual")
+		return 0, false
+	}
+	return version, true
+}
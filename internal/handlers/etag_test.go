@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	newRouter := func(require bool) *gin.Engine {
+		router := gin.New()
+		router.Use(Preconditions(require))
+		router.PUT("/itens", func(c *gin.Context) {
+			version, ok := ifMatchVersion(c)
+			if !ok {
+				return
+			}
+			c.String(http.StatusOK, strconv.Itoa(version))
+		})
+		return router
+	}
+
+	cases := []struct {
+		require bool
+		ifMatch string
+		status  int
+		version string
+	}{
+		{false, "", http.StatusOK, "0"},
+		{true, "", http.StatusPreconditionRequired, ""},
+		{true, "*", http.StatusOK, "0"},
+		{true, `"3"`, http.StatusOK, "3"},
+		{false, `W/"3"`, http.StatusPreconditionFailed, ""},
+		{false, `"abc"`, http.StatusPreconditionFailed, ""},
+		{false, "3", http.StatusPreconditionFailed, ""},
+		{false, `"3", "4"`, http.StatusBadRequest, ""},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("PUT", "/itens", nil)
+		if tc.ifMatch != "" {
+			req.Header.Set("If-Match", tc.ifMatch)
+		}
+		w := httptest.NewRecorder()
+		newRouter(tc.require).ServeHTTP(w, req)
+		assert.Equal(t, tc.status, w.Code, tc.ifMatch)
+		if tc.version != "" {
+			assert.Equal(t, tc.version, w.Body.String(), tc.ifMatch)
+		}
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/itens", func(c *gin.Context) {
+		if notModified(c, 2) {
+			return
+		}
+		setETag(c, 2)
+		c.JSON(http.StatusOK, gin.H{"version": 2})
+	})
+
+	cases := []struct {
+		ifNoneMatch string
+		status      int
+	}{
+		{"", http.StatusOK},
+		{`"1"`, http.StatusOK},
+		{`"2"`, http.StatusNotModified},
+		{`W/"2"`, http.StatusNotModified},
+		{`"1", "2"`, http.StatusNotModified},
+		{"*", http.StatusNotModified},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", "/itens", nil)
+		if tc.ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", tc.ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.status, w.Code, tc.ifNoneMatch)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"), tc.ifNoneMatch)
+	}
+}
@@ -3,132 +3,162 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/danubiobwm/company-api/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"gorm.io/gorm"
 )
 
 // GerenteColaboradoresResponse represents the response structure for gerente's colaboradores
 type GerenteColaboradoresResponse struct {
-	GerenteID     uuid.UUID               `json:"gerente_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Departamentos []DepartamentoHierarchy `json:"departamentos"`
-	Colaboradores []ColaboradorSummary    `json:"colaboradores"`
+	GerenteID                uuid.UUID               `json:"gerente_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DepartamentosGerenciados []DepartamentoHierarchy `json:"departamentos_gerenciados"`
+	Departamentos            []DepartamentoHierarchy `json:"departamentos"`
+	Colaboradores            []ColaboradorSummary    `json:"colaboradores"`
+	Meta                     pagination.Meta         `json:"meta"`
+	Links                    pagination.Links        `json:"links"`
 }
 
 // DepartamentoHierarchy represents a department in the hierarchy
 type DepartamentoHierarchy struct {
-	ID   uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Nome string    `json:"nome" example:"Technology Department"`
+	ID                uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome              string    `json:"nome" example:"Technology Department"`
+	ViaDepartamentoID uuid.UUID `json:"via_departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nivel             int       `json:"nivel" example:"1"`
 }
 
 // ColaboradorSummary represents a simplified colaborador
 type ColaboradorSummary struct {
-	ID             uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name           string    `json:"name" example:"John Doe"`
-	Email          string    `json:"email" example:"john.doe@company.com"`
-	DepartamentoID uuid.UUID `json:"departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ID                uuid.UUID  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome              string     `json:"nome" example:"John Doe"`
+	Email             *string    `json:"email,omitempty" example:"john.doe@company.com"`
+	Telefone          *string    `json:"telefone,omitempty" example:"1133334444"`
+	Celular           *string    `json:"celular,omitempty" example:"11999998888"`
+	DepartamentoID    uuid.UUID  `json:"departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CargoID           *uuid.UUID `json:"cargo_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ViaDepartamentoID uuid.UUID  `json:"via_departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string `json:"error" example:"Bad Request"`
-	Message string `json:"message" example:"Invalid input data"`
+type GerenteHandler struct {
+	service *services.GerenteService
 }
 
-// RegisterGerentesRoutes registra as rotas relacionadas a gerentes
-func RegisterGerenteRoutes(rg *gin.RouterGroup, db *gorm.DB) {
-	rg.GET("/gerentes/:id/colaboradores", getGerenteColaboradores(db))
+func NewGerenteHandler(s *services.GerenteService) *GerenteHandler {
+	return &GerenteHandler{service: s}
 }
 
-// GetGerenteColaboradores godoc
+// RegisterRoutes registra as rotas relacionadas a gerentes
+func (h *GerenteHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/gerentes/:id/colaboradores", h.GetColaboradores)
+}
+
+// GerenteColaboradoresQuery representa os parâmetros aceitos na listagem dos colaboradores do gerente.
+type GerenteColaboradoresQuery struct {
+	Page              int    `form:"page" binding:"omitempty,min=1"`
+	Limit             int    `form:"limit" binding:"omitempty,min=1"`
+	Sort              string `form:"sort"`
+	Nome              string `form:"nome"`
+	CPF               string `form:"cpf"`
+	Email             string `form:"email"`
+	DepartamentoID    string `form:"departamento_id" binding:"omitempty,uuid"`
+	CargoID           string `form:"cargo_id" binding:"omitempty,uuid"`
+	ViaDepartamentoID string `form:"via_departamento_id" binding:"omitempty,uuid"`
+	AsOf              string `form:"as_of" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// GetColaboradores godoc
 // @Summary Get colaboradores under gerente's hierarchy
-// @Description Get all colaboradores under a gerente's department hierarchy (including sub-departments)
+// @Description Get all colaboradores under the hierarchies of every departamento the gerente manages (including sub-departments).
+// @Description Overlapping subtrees are returned once; via_departamento_id tells which managed departamento each item was reached through.
+// @Description With as_of, departamentos, gerentes and lotações are reconstructed as they were at the end of that date.
 // @Tags gerentes
 // @Accept json
 // @Produce json
 // @Param id path string true "Gerente ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (e.g. nome,-created_at)"
+// @Param nome query string false "Filter by name (partial, case-insensitive)"
+// @Param cpf query string false "Filter by CPF, with or without punctuation"
+// @Param email query string false "Filter by corporate or personal email"
+// @Param departamento_id query string false "Filter by departamento ID (UUID)"
+// @Param cargo_id query string false "Filter by cargo ID (UUID)"
+// @Param via_departamento_id query string false "Only colaboradores reached through this managed departamento (UUID)"
+// @Param as_of query string false "Reconstruct the hierarchy as of this date (YYYY-MM-DD)"
 // @Success 200 {object} GerenteColaboradoresResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/gerentes/{id}/colaboradores [get]
-func getGerenteColaboradores(db *gorm.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		gerenteID, err := uuid.Parse(c.Param("id"))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "id inválido"})
-			return
-		}
-
-		// find department where this gerente_id is set
-		var deptIDStr string
-		if err := db.Raw("SELECT id FROM departamentos WHERE gerente_id = ? LIMIT 1", gerenteID).Scan(&deptIDStr).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		if deptIDStr == "" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "gerente não vinculado a nenhum departamento"})
-			return
-		}
-
-		deptID, err := uuid.Parse(deptIDStr)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao converter id de departamento"})
-			return
-		}
-
-		// Fetch recursively all sub-departments using CTE
-		type DeptRes struct {
-			ID   uuid.UUID `json:"id"`
-			Nome string    `json:"nome"`
-		}
-		var depts []DeptRes
-		sql := `
-		WITH RECURSIVE subdeps AS (
-			SELECT id, nome, departamento_superior_id
-			FROM departamentos
-			WHERE id = ?
-			UNION ALL
-			SELECT d.id, d.nome, d.departamento_superior_id
-			FROM departamentos d
-			INNER JOIN subdeps s ON d.departamento_superior_id = s.id
-		)
-		SELECT id, nome FROM subdeps;
-		`
-		if err := db.Raw(sql, deptID).Scan(&depts).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-
-		var ids []uuid.UUID
-		for _, d := range depts {
-			ids = append(ids, d.ID)
-		}
-
-		// Fetch collaborators in these departments
-		var colabs []ColaboradorSummary
-		if len(ids) > 0 {
-			if err := db.Table("colaboradores").Where("departamento_id IN ?", ids).Find(&colabs).Error; err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-				return
-			}
-		}
-
-		// Convert depts to the response format
-		var departamentos []DepartamentoHierarchy
-		for _, d := range depts {
-			departamentos = append(departamentos, DepartamentoHierarchy{
-				ID:   d.ID,
-				Nome: d.Nome,
-			})
-		}
+func (h *GerenteHandler) GetColaboradores(c *gin.Context) {
+	gerenteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeError(c, invalidParameter("id", "uuid", ""))
+		return
+	}
 
-		response := GerenteColaboradoresResponse{
-			GerenteID:     gerenteID,
-			Departamentos: departamentos,
-			Colaboradores: colabs,
-		}
+	var q GerenteColaboradoresQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+	sort, err := pagination.ParseSort(q.Sort, repositories.ColaboradorSortFields)
+	if err != nil {
+		writeError(c, invalidParameter("sort", "invalid", ""))
+		return
+	}
+	params := pagination.Params{Page: q.Page, Limit: q.Limit, Sort: sort}.Normalize()
+
+	filters := map[string]interface{}{
+		"nome":                q.Nome,
+		"cpf":                 q.CPF,
+		"email":               q.Email,
+		"departamento_id":     q.DepartamentoID,
+		"cargo_id":            q.CargoID,
+		"via_departamento_id": q.ViaDepartamentoID,
+	}
+	hierarchy, err := h.service.Colaboradores(gerenteID, filters, params, parseDate(q.AsOf))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 
-		c.JSON(http.StatusOK, response)
+	page := pagination.NewResponse(hierarchy.Colaboradores, hierarchy.Total, params, c.Request.URL)
+	response := GerenteColaboradoresResponse{
+		GerenteID:                hierarchy.GerenteID,
+		Meta:                     page.Meta,
+		Links:                    page.Links,
+		DepartamentosGerenciados: make([]DepartamentoHierarchy, 0, len(hierarchy.DepartamentosGerenciados)),
+		Departamentos:            make([]DepartamentoHierarchy, 0, len(hierarchy.Departamentos)),
+		Colaboradores:            make([]ColaboradorSummary, 0, len(hierarchy.Colaboradores)),
 	}
+	for _, d := range hierarchy.DepartamentosGerenciados {
+		response.DepartamentosGerenciados = append(response.DepartamentosGerenciados, DepartamentoHierarchy{
+			ID:                d.ID,
+			Nome:              d.Nome,
+			ViaDepartamentoID: d.ID,
+		})
+	}
+	for _, d := range hierarchy.Departamentos {
+		response.Departamentos = append(response.Departamentos, DepartamentoHierarchy{
+			ID:                d.ID,
+			Nome:              d.Nome,
+			ViaDepartamentoID: d.ViaDepartamentoID,
+			Nivel:             d.Nivel,
+		})
+	}
+	for _, colab := range hierarchy.Colaboradores {
+		response.Colaboradores = append(response.Colaboradores, ColaboradorSummary{
+			ID:                colab.ID,
+			Nome:              colab.Nome,
+			Email:             colab.Email,
+			Telefone:          colab.Telefone,
+			Celular:           colab.Celular,
+			DepartamentoID:    colab.DepartamentoID,
+			CargoID:           colab.CargoID,
+			ViaDepartamentoID: colab.ViaDepartamentoID,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
 }
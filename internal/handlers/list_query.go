@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/danubiobwm/company-api/internal/pagination"
+)
+
+// ListQuery contém os parâmetros de paginação comuns às listagens.
+// Com mode=cursor (ou quando um cursor é informado) a listagem usa keyset
+// sobre (created_at, id) em vez de OFFSET.
+type ListQuery struct {
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	Limit     int    `form:"limit" binding:"omitempty,min=1"`
+	Sort      string `form:"sort"`
+	Mode      string `form:"mode" binding:"omitempty,oneof=offset cursor"`
+	Cursor    string `form:"cursor"`
+	WithTotal bool   `form:"with_total"`
+}
+
+// CursorMode indica se a requisição pediu paginação por cursor.
+func (q ListQuery) CursorMode() bool {
+	return q.Mode == "cursor" || q.Cursor != ""
+}
+
+// OffsetParams valida a ordenação contra os campos permitidos e monta os parâmetros do modo offset.
+func (q ListQuery) OffsetParams(allowed map[string]string) (pagination.Params, error) {
+	sort, err := pagination.ParseSort(q.Sort, allowed)
+	if err != nil {
+		return pagination.Params{}, invalidParameter("sort", "invalid", "")
+	}
+	return pagination.Params{Page: q.Page, Limit: q.Limit, Sort: sort}.Normalize(), nil
+}
+
+// CursorParams monta os parâmetros do modo cursor. Nesse modo só é possível
+// ordenar por created_at, em ordem crescente ou decrescente.
+func (q ListQuery) CursorParams() (pagination.CursorParams, error) {
+	if q.Page != 0 {
+		return pagination.CursorParams{}, invalidParameter("page", "excluded_with", "cursor")
+	}
+	sort, err := pagination.ParseSort(q.Sort, map[string]string{"created_at": "created_at"})
+	if err != nil {
+		return pagination.CursorParams{}, invalidParameter("sort", "oneof", "created_at -created_at")
+	}
+
+	p := pagination.CursorParams{Limit: q.Limit, WithTotal: q.WithTotal}
+	if len(sort) == 1 {
+		p.Desc = sort[0].Desc
+	}
+	if q.Cursor != "" {
+		if p.After, err = pagination.DecodeCursor(q.Cursor); err != nil {
+			return pagination.CursorParams{}, invalidParameter("cursor", "invalid", "")
+		}
+	}
+	return p.Normalize(), nil
+}
+
+// parseInclude interpreta o parâmetro include (lista separada por vírgulas)
+// e rejeita valores fora de allowed.
+func parseInclude(raw string, allowed ...string) (map[string]bool, error) {
+	include := make(map[string]bool)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		ok := false
+		for _, a := range allowed {
+			if part == a {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return nil, invalidParameter("include", "oneof", strings.Join(allowed, " "))
+		}
+		include[part] = true
+	}
+	return include, nil
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrgHandler struct {
+	service *services.OrgService
+}
+
+func NewOrgHandler(s *services.OrgService) *OrgHandler {
+	return &OrgHandler{service: s}
+}
+
+// RegisterRoutes registra as rotas relacionadas à organização como um todo
+func (h *OrgHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/org/diff", h.Diff)
+}
+
+// OrgDiffQuery representa os parâmetros aceitos na comparação do organograma.
+type OrgDiffQuery struct {
+	From   string `form:"from" binding:"required,datetime=2006-01-02"`
+	To     string `form:"to" binding:"required,datetime=2006-01-02"`
+	Format string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// Diff godoc
+// @Summary Compare the organisation between two dates
+// @Description Report departamentos created, renamed, re-parented and deleted, gerente changes, and colaboradores hired, transferred or terminated between the end of `from` and the end of `to`.
+// @Description Both dates are compared as snapshots built from departamento versions and colaborador assignment history, so changes undone within the period are not listed.
+// @Tags org
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Param format query string false "Output format" Enums(json, csv) default(json)
+// @Success 200 {object} services.OrgDiff
+// @Failure 400 {object} ErrorResponse "Parâmetros inválidos"
+// @Failure 500 {object} ErrorResponse "Erro interno"
+// @Router /api/v1/org/diff [get]
+func (h *OrgHandler) Diff(c *gin.Context) {
+	var q OrgDiffQuery
+	if !bindQuery(c, &q) {
+		return
+	}
+
+	diff, err := h.service.Diff(*parseDate(q.From), *parseDate(q.To))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if q.Format == "csv" {
+		filename := fmt.Sprintf("org-diff-%s-%s.csv", q.From, q.To)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		if err := writeOrgDiffCSV(c.Writer, diff); err != nil {
+			_ = c.Error(err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, diff)
+}
+
+// writeOrgDiffCSV escreve uma linha por alteração, na mesma ordem do JSON.
+func writeOrgDiffCSV(w http.ResponseWriter, diff *services.OrgDiff) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"tipo", "entidade_id", "nome", "departamento_id", "de_id", "de", "para_id", "para"}); err != nil {
+		return err
+	}
+	for _, a := range diff.Alteracoes {
+		row := []string{a.Tipo, a.EntidadeID.String(), a.Nome,
+			optionalID(a.DepartamentoID), optionalID(a.DeID), optionalString(a.De), optionalID(a.ParaID), optionalString(a.Para)}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
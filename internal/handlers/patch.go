@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// mergePatchContentType é o tipo de mídia do JSON Merge Patch (RFC 7396).
+const mergePatchContentType = "application/merge-patch+json"
+
+// readMergePatch lê o corpo de um PATCH. Aceita application/merge-patch+json e,
+// por compatibilidade, application/json. Quando retorna ok=false a resposta de
+// erro já foi escrita.
+func readMergePatch(c *gin.Context) (patch []byte, ok bool) {
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || (mediaType != mergePatchContentType && mediaType != "application/json") {
+		c.Header("Accept-Patch", mergePatchContentType)
+		writeProblem(c, http.StatusUnsupportedMediaType, dderr.CodeUnsupportedMediaType, "Content-Type deve ser "+mergePatchContentType)
+		return nil, false
+	}
+	patch, err = c.GetRawData()
+	if err != nil {
+		writeKeyedProblem(c, http.StatusBadRequest, dderr.CodeInvalidParameter, "requisicao.corpo_invalido", "corpo da requisição inválido")
+		return nil, false
+	}
+	return patch, true
+}
+
+// validateColaborador aplica ao colaborador resultante de um PATCH as regras de
+// campo do corpo do PUT, que o merge patch não passa pelo binding.
+func validateColaborador(c *models.Colaborador) error {
+	return validatePatched(newColaboradorUpdateRequest(c))
+}
+
+// validateDepartamento é validateColaborador para departamentos.
+func validateDepartamento(d *models.Departamento) error {
+	return validatePatched(newDepartamentoRequest(d))
+}
+
+func validatePatched(req interface{}) error {
+	setupValidator()
+	err := binding.Validator.ValidateStruct(req)
+	if err == nil {
+		return nil
+	}
+	fields := fieldErrors(err)
+	if fields == nil {
+		return err
+	}
+	domainErr := dderr.NewWithCode(dderr.CodeValidationFailed, "dados da requisição inválidos")
+	domainErr.Fields = fields
+	return domainErr
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePatchedAppliesPutRules(t *testing.T) {
+	colab := models.Colaborador{
+		Nome:           "Ana Souza",
+		CPF:            "52998224725",
+		DepartamentoID: uuid.New(),
+		Status:         models.StatusAtivo,
+	}
+	require.NoError(t, validateColaborador(&colab))
+
+	dept := models.Departamento{Nome: "TI"}
+	require.NoError(t, validateDepartamento(&dept))
+
+	cases := []struct {
+		name  string
+		nome  string
+		field dderr.FieldError
+	}{
+		// {"nome": ""}
+		{"vazio", "", dderr.FieldError{Field: "nome", Code: "required", Message: "campo obrigatório"}},
+		// {"nome": "<101 caracteres>"}
+		{"longo", strings.Repeat("a", 101), dderr.FieldError{Field: "nome", Code: "max", Param: "100", Message: "deve ter no máximo 100 caracteres"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, d := colab, dept
+			c.Nome, d.Nome = tc.nome, tc.nome
+			for _, err := range []error{validateColaborador(&c), validateDepartamento(&d)} {
+				var domainErr *dderr.DomainError
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, dderr.CodeValidationFailed, domainErr.Code)
+				assert.Equal(t, []dderr.FieldError{tc.field}, domainErr.Fields)
+			}
+		})
+	}
+
+	telefone := strings.Repeat("9", 21)
+	c := colab
+	c.Telefone = &telefone
+	var domainErr *dderr.DomainError
+	require.True(t, errors.As(validateColaborador(&c), &domainErr))
+	assert.Equal(t, "telefone", domainErr.Fields[0].Field)
+}
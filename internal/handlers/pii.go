@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"github.com/danubiobwm/company-api/internal/cpf"
+	"github.com/gin-gonic/gin"
+)
+
+const maskCPFContextKey = "mask_cpf"
+
+// CPFMasking liga o mascaramento de CPF nas respostas (***.150.753-**) para quem
+// não tem o escopo pii. Desligado, todos recebem o CPF formatado. Deve ser
+// registrado depois de AdminAuth.
+func CPFMasking(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if enabled {
+			// a mesma URL muda conforme a credencial; caches não podem misturar as duas
+			c.Header("Vary", "X-Admin-Token")
+		}
+		c.Set(maskCPFContextKey, enabled && !hasPIIScope(c))
+		c.Next()
+	}
+}
+
+// hasPIIScope indica se a requisição pode ver dados pessoais sem máscara. Por
+// enquanto o escopo pii vem com o token de administrador.
+func hasPIIScope(c *gin.Context) bool {
+	return isAdmin(c)
+}
+
+// cpfView é como o CPF aparece nas respostas desta requisição: formatado ou,
+// no modo de mascaramento, mascarado.
+func cpfView(c *gin.Context) func(string) string {
+	if c.GetBool(maskCPFContextKey) {
+		return cpf.Mask
+	}
+	return cpf.Format
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPFMaskingDependsOnPIIScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	colab := models.Colaborador{Nome: "Ana", CPF: "00615075398"}
+	newRouter := func(mask bool) *gin.Engine {
+		router := gin.New()
+		router.Use(AdminAuth("segredo"), CPFMasking(mask))
+		router.GET("/colaborador", func(c *gin.Context) {
+			c.JSON(http.StatusOK, newColaboradorResponse(&colab, cpfView(c)))
+		})
+		return router
+	}
+
+	cases := []struct {
+		mask  bool
+		token string
+		cpf   string
+	}{
+		{false, "", "006.150.753-98"},
+		{true, "", "***.150.753-**"},
+		{true, "errado", "***.150.753-**"},
+		{true, "segredo", "006.150.753-98"},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", "/colaborador", nil)
+		if tc.token != "" {
+			req.Header.Set("X-Admin-Token", tc.token)
+		}
+		w := httptest.NewRecorder()
+		newRouter(tc.mask).ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp ColaboradorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, tc.cpf, resp.CPF, tc.token)
+		if tc.mask {
+			assert.Equal(t, "X-Admin-Token", w.Header().Get("Vary"))
+		}
+	}
+}
+
+func TestNestedColaboradoresUseCPFView(t *testing.T) {
+	colab := models.Colaborador{Nome: "Ana", CPF: "00615075398"}
+	mask := func(string) string { return "***.150.753-**" }
+
+	transfer := newTransferResponse(&services.TransferResult{Colaborador: &colab}, mask)
+	assert.Equal(t, "***.150.753-**", transfer.Colaborador.CPF)
+
+	merge := newMergeResponse(&services.MergeResult{Departamento: &models.Departamento{Nome: "TI", Gerente: &colab}}, mask)
+	require.NotNil(t, merge.Departamento.Gerente)
+	assert.Equal(t, "***.150.753-**", merge.Departamento.Gerente.CPF)
+
+	child := &services.DepartamentoNode{Nome: "Infra", Colaboradores: []models.Colaborador{colab}}
+	tree := newDepartamentoTreeNode(&services.DepartamentoNode{Nome: "TI", Subdepartamentos: []*services.DepartamentoNode{child}}, mask)
+	require.Len(t, tree.Subdepartamentos, 1)
+	require.Len(t, tree.Subdepartamentos[0].Colaboradores, 1)
+	assert.Equal(t, "***.150.753-**", tree.Subdepartamentos[0].Colaboradores[0].CPF)
+	assert.Equal(t, "00615075398", child.Colaboradores[0].CPF)
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/danubiobwm/company-api/internal/audit"
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// problemContentType é o tipo de mídia das respostas de erro (RFC 7807).
+const problemContentType = "application/problem+json"
+
+// ErrorResponse é o corpo de toda resposta de erro da API, no formato
+// application/problem+json (RFC 7807). Code é o identificador estável do erro;
+// TraceID é o mesmo valor do cabeçalho X-Request-ID. Dados extras do erro (por
+// exemplo, contagens em um conflito) são incluídos como membros adicionais.
+// Detail e as mensagens de Errors seguem o idioma negociado por Accept-Language
+// (pt-BR ou en), informado em Content-Language.
+type ErrorResponse struct {
+	Type     string                 `json:"type" example:"about:blank"`
+	Title    string                 `json:"title" example:"Not Found"`
+	Status   int                    `json:"status" example:"404"`
+	Detail   string                 `json:"detail" example:"colaborador não encontrado"`
+	Instance string                 `json:"instance" example:"/api/v1/colaboradores/123e4567-e89b-12d3-a456-426614174000"`
+	Code     string                 `json:"code" example:"COLABORADOR_NOT_FOUND"`
+	TraceID  string                 `json:"trace_id" example:"8f14e45f-ceea-467f-a0e6-0b8a2f3c9d71"`
+	Errors   []dderr.FieldError     `json:"errors,omitempty"`
+	Extras   map[string]interface{} `json:"-"`
+
+	// key e params escolhem a mensagem específica do erro no catálogo, quando o
+	// código é compartilhado por vários erros (ver DomainError.Key).
+	key    string
+	params map[string]interface{}
+}
+
+// MarshalJSON inclui Extras no mesmo nível dos demais membros, como a RFC pede
+// para membros de extensão. Os membros padrão prevalecem em caso de conflito.
+func (p ErrorResponse) MarshalJSON() ([]byte, error) {
+	type plain ErrorResponse
+	base, err := json.Marshal(plain(p))
+	if err != nil || len(p.Extras) == 0 {
+		return base, err
+	}
+	var members map[string]interface{}
+	if err := json.Unmarshal(base, &members); err != nil {
+		return nil, err
+	}
+	for k, v := range p.Extras {
+		if _, exists := members[k]; !exists {
+			members[k] = v
+		}
+	}
+	return json.Marshal(members)
+}
+
+// ErrorHandler escreve como problem+json o último erro registrado com c.Error
+// pelos handlers, quando eles não escreveram resposta. Deve ser registrado
+// depois de RequestContext para incluir o trace_id.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		writeError(c, c.Errors.Last().Err)
+	}
+}
+
+// writeError traduz err em problem+json. Erros de domínio recebem o status do
+// seu tipo; os demais são falhas internas e não têm a mensagem exposta.
+func writeError(c *gin.Context, err error) {
+	var domainErr *dderr.DomainError
+	if !errors.As(err, &domainErr) {
+		writeProblem(c, http.StatusInternalServerError, dderr.CodeInternal, "erro interno")
+		return
+	}
+	p := newProblem(c, statusOf(c, domainErr), domainErr.Code, domainErr.Message)
+	p.Errors = domainErr.Fields
+	p.Extras = domainErr.Details
+	p.key, p.params = domainErr.Key, domainErr.Params
+	renderProblem(c, p)
+}
+
+// statusOf é o status HTTP de um erro de domínio. Uma versão desatualizada é
+// 412 quando o cliente enviou If-Match e 409 quando outra requisição gravou o
+// registro durante esta.
+func statusOf(c *gin.Context, err *dderr.DomainError) int {
+	switch err.Kind() {
+	case dderr.KindInvalid:
+		return http.StatusBadRequest
+	case dderr.KindNotFound:
+		return http.StatusNotFound
+	case dderr.KindConflict:
+		return http.StatusConflict
+	case dderr.KindPrecondition:
+		if c.GetHeader("If-Match") != "" {
+			return http.StatusPreconditionFailed
+		}
+		return http.StatusConflict
+	default:
+		return http.StatusUnprocessableEntity
+	}
+}
+
+// writeProblem escreve um erro detectado no próprio handler (acesso negado,
+// cabeçalho ausente). Parâmetros inválidos usam invalidParameter.
+func writeProblem(c *gin.Context, status int, code, detail string) {
+	renderProblem(c, newProblem(c, status, code, detail))
+}
+
+// writeKeyedProblem é writeProblem para um detail mais específico que a
+// mensagem do código, traduzido pela mensagem key do catálogo.
+func writeKeyedProblem(c *gin.Context, status int, code, key, detail string) {
+	p := newProblem(c, status, code, detail)
+	p.key = key
+	renderProblem(c, p)
+}
+
+func newProblem(c *gin.Context, status int, code, detail string) ErrorResponse {
+	return ErrorResponse{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+		TraceID:  audit.RequestID(c.Request.Context()),
+	}
+}
+
+// invalidParameter é o erro de um parâmetro de rota, de query string ou de
+// cabeçalho, com a regra violada como em um campo do corpo.
+func invalidParameter(name, rule, param string) *dderr.DomainError {
+	msg := name + " inválido"
+	return dderr.NewWithCode(dderr.CodeInvalidParameter, msg).
+		WithKey("parametro.invalido", map[string]interface{}{"name": name}).
+		WithFieldParam(name, rule, param, msg)
+}
+
+// renderProblem traduz detail e as mensagens dos campos para o idioma pedido em
+// Accept-Language. Erros com mensagem específica (key) usam a entrada dela, e
+// não a genérica do código; o texto original fica quando o catálogo não tem a
+// entrada.
+func renderProblem(c *gin.Context, p ErrorResponse) {
+	lang := i18n.Negotiate(c.GetHeader("Accept-Language"))
+	key, params := p.Code, p.Extras
+	if p.key != "" {
+		key, params = p.key, p.params
+	}
+	if msg, ok := i18n.Message(lang, key, params); ok {
+		p.Detail = msg
+	}
+	if len(p.Errors) > 0 {
+		fields := make([]dderr.FieldError, len(p.Errors))
+		for i, fe := range p.Errors {
+			if msg, ok := i18n.Rule(lang, fe.Code, fe.Param); ok {
+				fe.Message = msg
+			}
+			fields[i] = fe
+		}
+		p.Errors = fields
+	}
+	c.Header("Content-Type", problemContentType)
+	c.Header("Content-Language", lang)
+	c.AbortWithStatusJSON(p.Status, p)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandlerWritesProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestContext(), ErrorHandler())
+	failures := map[string]error{
+		"/nao-encontrado": dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado"),
+		"/conflito": dderr.NewWithCode(dderr.CodeDepartamentoNotEmpty, "departamento possui colaboradores").
+			WithDetails(map[string]interface{}{"colaboradores": 3}),
+		"/versao":  dderr.NewWithCode(dderr.CodeVersionConflict, "registro alterado por outra requisição"),
+		"/regra":   dderr.NewWithCode(dderr.CodeDepartamentoCycle, "ciclo na hierarquia"),
+		"/interno": errors.New("conexão recusada"),
+	}
+	for path, err := range failures {
+		router.GET(path, func(c *gin.Context) { _ = c.Error(err) })
+	}
+
+	cases := []struct {
+		path, ifMatch string
+		status        int
+		code          string
+	}{
+		{"/nao-encontrado", "", http.StatusNotFound, dderr.CodeColaboradorNotFound},
+		{"/conflito", "", http.StatusConflict, dderr.CodeDepartamentoNotEmpty},
+		{"/versao", "", http.StatusConflict, dderr.CodeVersionConflict},
+		{"/versao", `"2"`, http.StatusPreconditionFailed, dderr.CodeVersionConflict},
+		{"/regra", "", http.StatusUnprocessableEntity, dderr.CodeDepartamentoCycle},
+		{"/interno", "", http.StatusInternalServerError, dderr.CodeInternal},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", tc.path, nil)
+		req.Header.Set("X-Request-ID", "req-42")
+		if tc.ifMatch != "" {
+			req.Header.Set("If-Match", tc.ifMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, tc.status, w.Code, tc.path)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"), tc.path)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body), tc.path)
+		assert.Equal(t, tc.code, body["code"], tc.path)
+		assert.Equal(t, float64(tc.status), body["status"], tc.path)
+		assert.Equal(t, tc.path, body["instance"], tc.path)
+		assert.Equal(t, "req-42", body["trace_id"], tc.path)
+		if tc.path == "/conflito" {
+			assert.Equal(t, float64(3), body["colaboradores"])
+		}
+		if tc.path == "/interno" {
+			assert.Equal(t, "erro interno", body["detail"])
+		}
+	}
+}
+
+func TestErrorHandlerLocalisesMessages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/colaboradores/:id", func(c *gin.Context) {
+		_ = c.Error(dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "nome é obrigatório").
+			WithKey("colaborador.nome_obrigatorio", nil).
+			WithField("nome", "required", "nome é obrigatório"))
+	})
+	router.GET("/colaboradores/2", func(c *gin.Context) {
+		_ = c.Error(dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "dados inválidos").
+			WithField("nome", "required", "nome é obrigatório"))
+	})
+	router.GET("/colaboradores/3", func(c *gin.Context) {
+		_ = c.Error(dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "regra sem tradução").
+			WithKey("colaborador.sem_traducao", nil).
+			WithField("nome", "required", "nome é obrigatório"))
+	})
+	router.GET("/colaboradores", func(c *gin.Context) {
+		writeError(c, invalidParameter("include", "oneof", "gerente colaboradores"))
+	})
+
+	cases := []struct {
+		path, acceptLanguage, lang string
+		detail, field              string
+	}{
+		{"/colaboradores/1", "", "pt-BR", "nome é obrigatório", "campo obrigatório"},
+		{"/colaboradores/1", "en-US,en;q=0.9", "en", "name is required", "required field"},
+		// sem chave, vale a mensagem do código
+		{"/colaboradores/2", "", "pt-BR", "dados do colaborador inválidos", "campo obrigatório"},
+		{"/colaboradores/2", "en", "en", "invalid employee data", "required field"},
+		// chave fora do catálogo mantém o texto do serviço
+		{"/colaboradores/3", "en", "en", "regra sem tradução", "required field"},
+		{"/colaboradores", "en", "en", "invalid parameter include", "must be one of: gerente, colaboradores"},
+		{"/colaboradores", "fr", "pt-BR", "parâmetro include inválido", "deve ser um de: gerente, colaboradores"},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", tc.path, nil)
+		req.Header.Set("Accept-Language", tc.acceptLanguage)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, tc.acceptLanguage)
+		assert.Equal(t, tc.lang, w.Header().Get("Content-Language"), tc.acceptLanguage)
+		var resp ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, tc.detail, resp.Detail, tc.acceptLanguage)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, tc.field, resp.Errors[0].Message, tc.acceptLanguage)
+		}
+	}
+}
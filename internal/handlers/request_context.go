@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/danubiobwm/company-api/internal/audit"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	actorHeader     = "X-Actor"
+
+	maxRequestIDLength = 64
+	maxActorLength     = 100
+)
+
+// RequestContext identifica a requisição e quem a fez, para a trilha de auditoria.
+// O id vem de X-Request-ID (ou é gerado) e volta no mesmo cabeçalho da resposta.
+// O ator é sempre o da credencial: "admin" para requisições autenticadas por
+// AdminAuth e "anonimo" nas demais. X-Actor só é aceito de administradores e é
+// registrado à parte, como ator declarado. Deve ser registrado depois de AdminAuth.
+func RequestContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := strings.TrimSpace(c.GetHeader(requestIDHeader))
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+		c.Header(requestIDHeader, requestID)
+
+		ctx := audit.WithRequestID(c.Request.Context(), requestID)
+		if isAdmin(c) {
+			ctx = audit.WithActor(ctx, "admin")
+			claimed := strings.TrimSpace(c.GetHeader(actorHeader))
+			if runes := []rune(claimed); len(runes) > maxActorLength {
+				claimed = string(runes[:maxActorLength])
+			}
+			if claimed != "" {
+				ctx = audit.WithClaimedActor(ctx, claimed)
+			}
+		} else {
+			ctx = audit.WithActor(ctx, "anonimo")
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/audit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestContextSetsActorAndRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(AdminAuth("segredo"), RequestContext())
+	router.GET("/quem", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		c.JSON(http.StatusOK, gin.H{
+			"ator":           audit.Actor(ctx),
+			"ator_declarado": audit.ClaimedActor(ctx),
+			"request_id":     audit.RequestID(ctx),
+		})
+	})
+
+	cases := []struct {
+		actor, token, requestID string
+		wantActor, wantClaimed  string
+	}{
+		{"", "", "", "anonimo", ""},
+		{"", "segredo", "", "admin", ""},
+		{"maria.souza", "", "req-123", "anonimo", ""},
+		{"maria.souza", "segredo", "", "admin", "maria.souza"},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", "/quem", nil)
+		if tc.actor != "" {
+			req.Header.Set("X-Actor", tc.actor)
+		}
+		if tc.token != "" {
+			req.Header.Set("X-Admin-Token", tc.token)
+		}
+		if tc.requestID != "" {
+			req.Header.Set("X-Request-ID", tc.requestID)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Contains(t, w.Body.String(), `"ator":"`+tc.wantActor+`"`)
+		assert.Contains(t, w.Body.String(), `"ator_declarado":"`+tc.wantClaimed+`"`)
+		got := w.Header().Get("X-Request-ID")
+		assert.NotEmpty(t, got)
+		if tc.requestID != "" {
+			assert.Equal(t, tc.requestID, got)
+		}
+		assert.Contains(t, w.Body.String(), `"request_id":"`+got+`"`)
+	}
+}
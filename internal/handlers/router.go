@@ -2,14 +2,20 @@ package handlers
 
 import (
 	_ "github.com/danubiobwm/company-api/docs"
+	"github.com/danubiobwm/company-api/internal/config"
 	"github.com/danubiobwm/company-api/internal/repositories"
 	"github.com/danubiobwm/company-api/internal/services"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
-func RegisterRoutes(r *gin.Engine, db *gorm.DB) {
+func RegisterRoutes(r *gin.Engine, db *gorm.DB, cfg config.Config) {
 	api := r.Group("/api/v1")
+	api.Use(AdminAuth(cfg.AdminToken))
+	api.Use(RequestContext())
+	api.Use(ErrorHandler())
+	api.Use(Preconditions(cfg.RequireIfMatch))
+	api.Use(CPFMasking(cfg.MaskCPF))
 
 	// Health check
 	api.GET("/health", func(c *gin.Context) {
@@ -19,21 +25,36 @@ func RegisterRoutes(r *gin.Engine, db *gorm.DB) {
 	// Instâncias de repositórios
 	deptRepo := repositories.NewDepartamentoRepository(db)
 	colabRepo := repositories.NewColaboradorRepository(db)
+	cargoRepo := repositories.NewCargoRepository(db)
+	lotacaoRepo := repositories.NewLotacaoRepository(db)
+	auditRepo := repositories.NewAuditRepository(db)
+	uow := repositories.NewUnitOfWork(db)
 
 	// Services
-	deptService := services.NewDepartamentoService(deptRepo, colabRepo)
-	colabService := services.NewColaboradorService(colabRepo, deptRepo)
+	deptService := services.NewDepartamentoService(uow, cfg.MaxDepartamentoDepth)
+	colabService := services.NewColaboradorService(uow)
+	gerenteService := services.NewGerenteService(deptRepo, colabRepo, lotacaoRepo)
+	cargoService := services.NewCargoService(cargoRepo, deptRepo)
+	orgService := services.NewOrgService(deptRepo, lotacaoRepo)
+	auditService := services.NewAuditService(auditRepo)
 
 	// Handlers
 	deptHandler := NewDepartamentoHandler(deptService)
 	colabHandler := NewColaboradorHandler(colabService)
+	gerenteHandler := NewGerenteHandler(gerenteService)
+	cargoHandler := NewCargoHandler(cargoService)
+	orgHandler := NewOrgHandler(orgService)
+	auditHandler := NewAuditHandler(auditService)
 
 	// Registrar rotas
 	deptHandler.RegisterRoutes(api)
 	colabHandler.RegisterRoutes(api)
+	cargoHandler.RegisterRoutes(api)
+	orgHandler.RegisterRoutes(api)
+	auditHandler.RegisterRoutes(api)
 
 	// Registrar rotas do Gerente
-	RegisterGerenteRoutes(api, db)
+	gerenteHandler.RegisterRoutes(api)
 
 	// Registrar rotas do Swagger
 	RegisterSwaggerRoutes(r)
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/danubiobwm/company-api/internal/cpf"
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+var registerValidators sync.Once
+
+// setupValidator registra no validador do gin a regra cpf e faz os erros usarem
+// o nome JSON (ou de query string) do campo em vez do nome do campo Go.
+func setupValidator() {
+	registerValidators.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			for _, tag := range []string{"json", "form"} {
+				name := strings.Split(f.Tag.Get(tag), ",")[0]
+				if name == "-" {
+					return ""
+				}
+				if name != "" {
+					return name
+				}
+			}
+			return f.Name
+		})
+		_ = v.RegisterValidation("cpf", func(fl validator.FieldLevel) bool {
+			return cpf.Valid(fl.Field().String())
+		})
+	})
+}
+
+// bindJSON decodifica e valida o corpo JSON. Quando retorna false a resposta 400
+// já foi escrita.
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	setupValidator()
+	if err := c.ShouldBindJSON(obj); err != nil {
+		writeBindError(c, err)
+		return false
+	}
+	return true
+}
+
+// bindQuery decodifica e valida a query string. Quando retorna false a resposta
+// 400 já foi escrita.
+func bindQuery(c *gin.Context, obj interface{}) bool {
+	setupValidator()
+	if err := c.ShouldBindQuery(obj); err != nil {
+		writeBindError(c, err)
+		return false
+	}
+	return true
+}
+
+func writeBindError(c *gin.Context, err error) {
+	fields := fieldErrors(err)
+	if fields == nil {
+		writeKeyedProblem(c, http.StatusBadRequest, dderr.CodeValidationFailed, "requisicao.corpo_invalido", "corpo da requisição inválido")
+		return
+	}
+	p := newProblem(c, http.StatusBadRequest, dderr.CodeValidationFailed, "dados da requisição inválidos")
+	p.Errors = fields
+	renderProblem(c, p)
+}
+
+// fieldErrors converte os erros de validação e de tipo em uma entrada por campo.
+// Retorna nil para erros que não se referem a um campo (ex.: JSON malformado).
+func fieldErrors(err error) []dderr.FieldError {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		out := make([]dderr.FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			out = append(out, dderr.FieldError{Field: fe.Field(), Code: fe.Tag(), Param: fe.Param(), Message: ruleMessage(fe.Tag(), fe.Param())})
+		}
+		return out
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		expected := jsonType(typeErr.Type)
+		return []dderr.FieldError{{Field: typeErr.Field, Code: "type", Param: expected, Message: ruleMessage("type", expected)}}
+	}
+	return nil
+}
+
+// ruleMessage é a mensagem da regra no idioma padrão; renderProblem a troca pela
+// do idioma negociado.
+func ruleMessage(rule, param string) string {
+	if msg, ok := i18n.Rule(i18n.Default, rule, param); ok {
+		return msg
+	}
+	msg, _ := i18n.Rule(i18n.Default, "invalid", "")
+	return msg
+}
+
+// jsonType descreve um tipo Go pelo nome do tipo JSON correspondente.
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
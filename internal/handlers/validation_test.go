@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindJSONReportsFieldErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/colaboradores", func(c *gin.Context) {
+		var req ColaboradorCreateRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+	post := func(body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/colaboradores", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := post(`{"cpf": "12345678900", "email": "sem-arroba", "departamento_id": "x", "status": "desligado"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "VALIDATION_FAILED", resp.Code)
+	assert.Equal(t, http.StatusBadRequest, resp.Status)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.ElementsMatch(t, []dderr.FieldError{
+		{Field: "nome", Code: "required", Message: "campo obrigatório"},
+		{Field: "cpf", Code: "cpf", Message: "cpf inválido"},
+		{Field: "email", Code: "email", Message: "e-mail inválido"},
+		{Field: "departamento_id", Code: "uuid", Message: "deve ser um UUID"},
+		{Field: "status", Code: "oneof", Param: "ativo afastado", Message: "deve ser um de: ativo, afastado"},
+	}, resp.Errors)
+
+	w = post(`{"nome": 10}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	resp = ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []dderr.FieldError{{Field: "nome", Code: "type", Param: "string", Message: "tipo inválido, esperado string"}}, resp.Errors)
+
+	w = post(`{"nome": "Ana", "cpf": "52998224725", "departamento_id": "123e4567-e89b-12d3-a456-426614174000"}`)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestBindJSONLimitsNomeToColumnSize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/departamentos", func(c *gin.Context) {
+		var req DepartamentoRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+	post := func(nome string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(map[string]string{"nome": nome})
+		req, _ := http.NewRequest("POST", "/departamentos", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// a coluna é VARCHAR(100), contada em caracteres
+	assert.Equal(t, http.StatusNoContent, post(strings.Repeat("ç", 100)).Code)
+
+	w := post(strings.Repeat("ç", 101))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []dderr.FieldError{{Field: "nome", Code: "max", Param: "100", Message: "deve ter no máximo 100 caracteres"}}, resp.Errors)
+}
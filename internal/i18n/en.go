@@ -0,0 +1,110 @@
+package i18n
+
+import dderr "github.com/danubiobwm/company-api/internal/errors"
+
+var en = bundle{
+	messages: map[string]string{
+		dderr.CodeDepartamentoNotFound:            "department not found",
+		dderr.CodeDepartamentoSuperiorNotFound:    "parent department not found",
+		dderr.CodeDepartamentoSelfParent:          "a department cannot be its own parent",
+		dderr.CodeDepartamentoCycle:               "the change would create a cycle in the department hierarchy",
+		dderr.CodeDepartamentoMaxDepth:            "the department hierarchy cannot have more than {max_depth} levels",
+		dderr.CodeDepartamentoMergeSelf:           "a department cannot be merged into itself",
+		dderr.CodeDepartamentoNotEmpty:            "department has employees or sub-departments",
+		dderr.CodeInvalidDeleteStrategy:           "invalid delete strategy",
+		dderr.CodeDepartamentoNotDeleted:          "department is not deleted",
+		dderr.CodeDepartamentoInvalid:             "invalid department data",
+		dderr.CodeDepartamentoGerenteNotFound:     "manager not found",
+		dderr.CodeGerenteSemDepartamento:          "manager is not assigned to any department",
+		dderr.CodeColaboradorCPFInvalid:           "invalid CPF",
+		dderr.CodeColaboradorCPFDuplicate:         "CPF already registered",
+		dderr.CodeColaboradorRGDuplicate:          "RG already registered",
+		dderr.CodeColaboradorDepartamentoNotFound: "department does not exist",
+		dderr.CodeColaboradorEmailInvalid:         "invalid e-mail address",
+		dderr.CodeColaboradorEmailDuplicate:       "e-mail address already registered",
+		dderr.CodeColaboradorTelefoneInvalid:      "invalid phone number",
+		dderr.CodeColaboradorCargoNotFound:        "job title does not exist",
+		dderr.CodeColaboradorNotFound:             "employee not found",
+		dderr.CodeColaboradorStatusInvalid:        "invalid status",
+		dderr.CodeColaboradorTerminated:           "operation not allowed for a terminated employee",
+		dderr.CodeColaboradorNotTerminated:        "employee is not terminated",
+		dderr.CodeColaboradorDataInvalid:          "invalid employee data",
+		dderr.CodeColaboradorNotDeleted:           "employee is not deleted",
+		dderr.CodeColaboradorRestoreBlocked:       "the employee's department is deleted; restore it first",
+		dderr.CodeColaboradorTransferInvalid:      "invalid transfer",
+		dderr.CodeColaboradorTransferNotScheduled: "employee has no scheduled transfer",
+		dderr.CodeCargoNotFound:                   "job title not found",
+		dderr.CodeCargoInvalid:                    "invalid job title data",
+		dderr.CodeCargoDuplicate:                  "job title already registered",
+		dderr.CodeCargoInUse:                      "job title has employees",
+		dderr.CodeOrgDiffPeriodInvalid:            "from must be before or equal to to",
+		dderr.CodeVersionConflict:                 "the record was changed by another request; reload it and try again",
+		dderr.CodePatchInvalid:                    "invalid patch",
+		dderr.CodeValidationFailed:                "invalid request data",
+		dderr.CodeInvalidParameter:                "invalid parameter",
+		dderr.CodeForbidden:                       "operation requires administrator access",
+		dderr.CodePreconditionRequired:            "If-Match header required",
+		dderr.CodeUnsupportedMediaType:            "unsupported media type; use application/merge-patch+json",
+		dderr.CodeInternal:                        "internal error",
+
+		// mensagens específicas, indexadas por DomainError.Key
+		"cargo.vagas_negativas":                     "openings cannot be negative",
+		"cargo.sem_vagas":                           "the job title has no openings in the department",
+		"cargo.titulo_obrigatorio":                  "title is required",
+		"cargo.cbo_invalido":                        "invalid CBO code",
+		"cargo.faixa_negativa":                      "salary range cannot be negative",
+		"cargo.faixa_invertida":                     "salario_min is greater than salario_max",
+		"colaborador.nome_obrigatorio":              "name is required",
+		"colaborador.email_invalido":                "invalid e-mail address",
+		"colaborador.email_pessoal_invalido":        "invalid personal e-mail address",
+		"colaborador.telefone_invalido":             "invalid phone number",
+		"colaborador.celular_invalido":              "invalid mobile number",
+		"colaborador.status_use_desligamento":       "invalid status; use the termination endpoint",
+		"colaborador.desligado_use_readmissao":      "employee is terminated; use the rehire endpoint",
+		"colaborador.desligado_transferencia":       "a terminated employee cannot be transferred",
+		"colaborador.ja_desligado":                  "employee is already terminated",
+		"colaborador.motivo_obrigatorio":            "termination reason is required",
+		"colaborador.desligamento_antes_admissao":   "termination date is before the hire date",
+		"colaborador.readmissao_antes_desligamento": "rehire date must be after the termination date",
+		"departamento.nome_obrigatorio":             "name is required",
+		"departamento.origem_nao_encontrado":        "source department not found",
+		"departamento.destino_nao_encontrado":       "target department not found",
+		"departamento.destino_subordinado":          "the target department cannot be below the source department",
+		"departamento.superior_subordinado":         "the parent department cannot be below the department itself",
+		"departamento.target_obrigatorio":           "target is required with strategy=reassign",
+		"departamento.strategy_invalida":            "strategy must be restrict, reassign or cascade",
+		"transferencia.mesmo_departamento":          "employee is already assigned to the department",
+		"transferencia.data_anterior_lotacao":       "effective date is before the start of the current assignment",
+		"patch.objeto":                              "the patch must be a JSON object",
+		"patch.readonly":                            "field {field} cannot be changed",
+		"patch.required":                            "field {field} cannot be null",
+		"patch.type":                                "invalid value for field {field}",
+		"parametro.invalido":                        "invalid parameter {name}",
+		"acesso.auditoria":                          "the audit trail requires administrator access",
+		"acesso.include_deleted":                    "include_deleted requires administrator access",
+		"versao.if_match":                           "If-Match does not match the current version",
+		"requisicao.corpo_invalido":                 "invalid request body",
+	},
+	rules: map[string]string{
+		"required":      "required field",
+		"cpf":           "invalid CPF",
+		"email":         "invalid e-mail address",
+		"phone":         "invalid phone number",
+		"cbo":           "invalid CBO code, expected 6 digits",
+		"uuid":          "must be a UUID",
+		"max":           "must be at most {param} characters long",
+		"min":           "must be at least {param}",
+		"oneof":         "must be one of: {param}",
+		"ltefield":      "cannot be greater than {param}",
+		"gtefield":      "cannot be before {param}",
+		"gtfield":       "must be after {param}",
+		"excluded_with": "cannot be used with {param}",
+		"datetime":      "invalid date, use the YYYY-MM-DD format",
+		"timestamp":     "invalid date, use YYYY-MM-DD or RFC 3339",
+		"type":          "invalid type, expected {param}",
+		"readonly":      "field cannot be changed",
+		"unchanged":     "same as the current value",
+		"single":        "accepts a single value",
+		"invalid":       "invalid value",
+	},
+}
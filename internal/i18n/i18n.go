@@ -0,0 +1,96 @@
+// Package i18n traduz as mensagens de erro da API. As mensagens são indexadas
+// pelo código estável do erro (DomainError.Code), pela chave da mensagem
+// específica (DomainError.Key) ou pela regra de validação de um campo, e o
+// idioma é negociado pelo cabeçalho Accept-Language.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Idiomas suportados. PtBR é o padrão quando o cliente não pede nenhum deles.
+const (
+	PtBR    = "pt-BR"
+	En      = "en"
+	Default = PtBR
+)
+
+// bundle reúne as mensagens de um idioma. messages é indexado pelo código do
+// erro ou pela chave da mensagem específica e rules pela regra de validação; {chave} é substituído pelos parâmetros.
+type bundle struct {
+	messages map[string]string
+	rules    map[string]string
+}
+
+var bundles = map[string]bundle{
+	PtBR: ptBR,
+	En:   en,
+}
+
+// a ordem segue a de matcher: o primeiro idioma é o usado sem correspondência
+var supported = []string{PtBR, En}
+
+var matcher = language.NewMatcher([]language.Tag{language.BrazilianPortuguese, language.English})
+
+// Negotiate escolhe, entre os idiomas suportados, o que melhor atende ao
+// cabeçalho Accept-Language. Cabeçalho ausente, inválido ou sem nenhum idioma
+// conhecido resulta no idioma padrão.
+func Negotiate(acceptLanguage string) string {
+	tags, weights, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return Default
+	}
+	accepted := tags[:0]
+	for i, tag := range tags {
+		// q=0 significa "não aceito"
+		if weights[i] > 0 {
+			accepted = append(accepted, tag)
+		}
+	}
+	if len(accepted) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(accepted...)
+	if confidence == language.No {
+		return Default
+	}
+	return supported[index]
+}
+
+// Message é a mensagem do código de erro no idioma lang, com os parâmetros
+// aplicados. Códigos sem tradução usam o idioma padrão; ok é false quando
+// nenhum dos dois tem o código.
+func Message(lang, code string, params map[string]interface{}) (msg string, ok bool) {
+	msg, ok = lookup(lang, func(b bundle) map[string]string { return b.messages }, code)
+	if !ok {
+		return "", false
+	}
+	for k, v := range params {
+		msg = strings.ReplaceAll(msg, "{"+k+"}", fmt.Sprint(v))
+	}
+	return msg, true
+}
+
+// Rule é a mensagem de um campo rejeitado pela regra de validação rule, com o
+// argumento da regra em {param}.
+func Rule(lang, rule, param string) (string, bool) {
+	msg, ok := lookup(lang, func(b bundle) map[string]string { return b.rules }, rule)
+	if !ok {
+		return "", false
+	}
+	if rule == "oneof" {
+		param = strings.ReplaceAll(param, " ", ", ")
+	}
+	return strings.ReplaceAll(msg, "{param}", param), true
+}
+
+func lookup(lang string, table func(bundle) map[string]string, key string) (string, bool) {
+	if msg, ok := table(bundles[lang])[key]; ok {
+		return msg, true
+	}
+	msg, ok := table(bundles[Default])[key]
+	return msg, ok
+}
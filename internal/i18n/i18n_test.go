@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"testing"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := map[string]string{
+		"":                          PtBR,
+		"en":                        En,
+		"en-US,en;q=0.9":            En,
+		"pt-BR,pt;q=0.9,en;q=0.8":   PtBR,
+		"fr-FR,en;q=0.5":            En,
+		"fr-FR":                     PtBR,
+		"pt":                        PtBR,
+		"en;q=0,pt;q=0.1":           PtBR,
+		"*":                         PtBR,
+		"isto não é um cabeçalho!!": PtBR,
+	}
+	for header, want := range cases {
+		assert.Equal(t, want, Negotiate(header), header)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	msg, ok := Message(En, dderr.CodeDepartamentoMaxDepth, map[string]interface{}{"max_depth": 10})
+	assert.True(t, ok)
+	assert.Equal(t, "the department hierarchy cannot have more than 10 levels", msg)
+
+	msg, ok = Message("de", dderr.CodeColaboradorNotFound, nil)
+	assert.True(t, ok)
+	assert.Equal(t, "colaborador não encontrado", msg, "idioma sem catálogo usa o padrão")
+
+	_, ok = Message(En, "CODIGO_DESCONHECIDO", nil)
+	assert.False(t, ok)
+
+	msg, _ = Rule(En, "oneof", "ativo afastado")
+	assert.Equal(t, "must be one of: ativo, afastado", msg)
+	msg, _ = Rule(PtBR, "max", "255")
+	assert.Equal(t, "deve ter no máximo 255 caracteres", msg)
+}
+
+// todo código e regra traduzido em um idioma precisa estar nos demais
+func TestBundlesHaveSameKeys(t *testing.T) {
+	for lang, b := range bundles {
+		for code := range ptBR.messages {
+			assert.Contains(t, b.messages, code, lang)
+		}
+		for rule := range ptBR.rules {
+			assert.Contains(t, b.rules, rule, lang)
+		}
+		assert.Len(t, b.messages, len(ptBR.messages), lang)
+		assert.Len(t, b.rules, len(ptBR.rules), lang)
+	}
+}
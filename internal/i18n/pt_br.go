@@ -0,0 +1,110 @@
+package i18n
+
+import dderr "github.com/danubiobwm/company-api/internal/errors"
+
+var ptBR = bundle{
+	messages: map[string]string{
+		dderr.CodeDepartamentoNotFound:            "departamento não encontrado",
+		dderr.CodeDepartamentoSuperiorNotFound:    "departamento superior não encontrado",
+		dderr.CodeDepartamentoSelfParent:          "departamento não pode ser superior de si mesmo",
+		dderr.CodeDepartamentoCycle:               "a alteração criaria um ciclo na hierarquia de departamentos",
+		dderr.CodeDepartamentoMaxDepth:            "hierarquia de departamentos não pode ter mais de {max_depth} níveis",
+		dderr.CodeDepartamentoMergeSelf:           "departamento não pode ser fundido com ele mesmo",
+		dderr.CodeDepartamentoNotEmpty:            "departamento possui colaboradores ou subdepartamentos",
+		dderr.CodeInvalidDeleteStrategy:           "estratégia de exclusão inválida",
+		dderr.CodeDepartamentoNotDeleted:          "departamento não está excluído",
+		dderr.CodeDepartamentoInvalid:             "dados do departamento inválidos",
+		dderr.CodeDepartamentoGerenteNotFound:     "gerente não encontrado",
+		dderr.CodeGerenteSemDepartamento:          "gerente não vinculado a nenhum departamento",
+		dderr.CodeColaboradorCPFInvalid:           "cpf inválido",
+		dderr.CodeColaboradorCPFDuplicate:         "cpf já cadastrado",
+		dderr.CodeColaboradorRGDuplicate:          "rg já cadastrado",
+		dderr.CodeColaboradorDepartamentoNotFound: "departamento não existe",
+		dderr.CodeColaboradorEmailInvalid:         "e-mail inválido",
+		dderr.CodeColaboradorEmailDuplicate:       "e-mail já cadastrado",
+		dderr.CodeColaboradorTelefoneInvalid:      "telefone inválido",
+		dderr.CodeColaboradorCargoNotFound:        "cargo não existe",
+		dderr.CodeColaboradorNotFound:             "colaborador não encontrado",
+		dderr.CodeColaboradorStatusInvalid:        "status inválido",
+		dderr.CodeColaboradorTerminated:           "operação não permitida para colaborador desligado",
+		dderr.CodeColaboradorNotTerminated:        "colaborador não está desligado",
+		dderr.CodeColaboradorDataInvalid:          "dados do colaborador inválidos",
+		dderr.CodeColaboradorNotDeleted:           "colaborador não está excluído",
+		dderr.CodeColaboradorRestoreBlocked:       "departamento do colaborador está excluído; restaure-o primeiro",
+		dderr.CodeColaboradorTransferInvalid:      "transferência inválida",
+		dderr.CodeColaboradorTransferNotScheduled: "colaborador não tem transferência agendada",
+		dderr.CodeCargoNotFound:                   "cargo não encontrado",
+		dderr.CodeCargoInvalid:                    "dados do cargo inválidos",
+		dderr.CodeCargoDuplicate:                  "cargo já cadastrado",
+		dderr.CodeCargoInUse:                      "cargo possui colaboradores",
+		dderr.CodeOrgDiffPeriodInvalid:            "from deve ser anterior ou igual a to",
+		dderr.CodeVersionConflict:                 "registro alterado por outra requisição; recarregue e tente novamente",
+		dderr.CodePatchInvalid:                    "patch inválido",
+		dderr.CodeValidationFailed:                "dados da requisição inválidos",
+		dderr.CodeInvalidParameter:                "parâmetro inválido",
+		dderr.CodeForbidden:                       "operação exige acesso de administrador",
+		dderr.CodePreconditionRequired:            "cabeçalho If-Match obrigatório",
+		dderr.CodeUnsupportedMediaType:            "tipo de mídia não suportado; use application/merge-patch+json",
+		dderr.CodeInternal:                        "erro interno",
+
+		// mensagens específicas, indexadas por DomainError.Key
+		"cargo.vagas_negativas":                     "vagas não pode ser negativo",
+		"cargo.sem_vagas":                           "cargo sem vagas no departamento",
+		"cargo.titulo_obrigatorio":                  "titulo é obrigatório",
+		"cargo.cbo_invalido":                        "cbo inválido",
+		"cargo.faixa_negativa":                      "faixa salarial não pode ser negativa",
+		"cargo.faixa_invertida":                     "salario_min maior que salario_max",
+		"colaborador.nome_obrigatorio":              "nome é obrigatório",
+		"colaborador.email_invalido":                "email inválido",
+		"colaborador.email_pessoal_invalido":        "email_pessoal inválido",
+		"colaborador.telefone_invalido":             "telefone inválido",
+		"colaborador.celular_invalido":              "celular inválido",
+		"colaborador.status_use_desligamento":       "status inválido; use o desligamento",
+		"colaborador.desligado_use_readmissao":      "colaborador desligado; use a readmissão",
+		"colaborador.desligado_transferencia":       "colaborador desligado não pode ser transferido",
+		"colaborador.ja_desligado":                  "colaborador já desligado",
+		"colaborador.motivo_obrigatorio":            "motivo do desligamento é obrigatório",
+		"colaborador.desligamento_antes_admissao":   "data de desligamento anterior à admissão",
+		"colaborador.readmissao_antes_desligamento": "data de readmissão deve ser posterior ao desligamento",
+		"departamento.nome_obrigatorio":             "nome é obrigatório",
+		"departamento.origem_nao_encontrado":        "departamento de origem não encontrado",
+		"departamento.destino_nao_encontrado":       "departamento de destino não encontrado",
+		"departamento.destino_subordinado":          "departamento de destino não pode ser um subordinado do departamento de origem",
+		"departamento.superior_subordinado":         "departamento superior não pode ser um subordinado do próprio departamento",
+		"departamento.target_obrigatorio":           "target é obrigatório com strategy=reassign",
+		"departamento.strategy_invalida":            "strategy deve ser restrict, reassign ou cascade",
+		"transferencia.mesmo_departamento":          "colaborador já está lotado no departamento",
+		"transferencia.data_anterior_lotacao":       "data efetiva anterior ao início da lotação atual",
+		"patch.objeto":                              "o patch deve ser um objeto JSON",
+		"patch.readonly":                            "campo {field} não pode ser alterado",
+		"patch.required":                            "campo {field} não pode ser nulo",
+		"patch.type":                                "valor inválido para o campo {field}",
+		"parametro.invalido":                        "parâmetro {name} inválido",
+		"acesso.auditoria":                          "trilha de auditoria exige acesso de administrador",
+		"acesso.include_deleted":                    "include_deleted exige acesso de administrador",
+		"versao.if_match":                           "If-Match não corresponde à versão atual",
+		"requisicao.corpo_invalido":                 "corpo da requisição inválido",
+	},
+	rules: map[string]string{
+		"required":      "campo obrigatório",
+		"cpf":           "cpf inválido",
+		"email":         "e-mail inválido",
+		"phone":         "telefone inválido",
+		"cbo":           "cbo inválido, informe 6 dígitos",
+		"uuid":          "deve ser um UUID",
+		"max":           "deve ter no máximo {param} caracteres",
+		"min":           "deve ser no mínimo {param}",
+		"oneof":         "deve ser um de: {param}",
+		"ltefield":      "não pode ser maior que {param}",
+		"gtefield":      "não pode ser anterior a {param}",
+		"gtfield":       "deve ser posterior a {param}",
+		"excluded_with": "não pode ser usado com {param}",
+		"datetime":      "data inválida, use o formato AAAA-MM-DD",
+		"timestamp":     "data inválida, use AAAA-MM-DD ou RFC 3339",
+		"type":          "tipo inválido, esperado {param}",
+		"readonly":      "campo não pode ser alterado",
+		"unchanged":     "igual ao valor atual",
+		"single":        "aceita um único valor",
+		"invalid":       "valor inválido",
+	},
+}
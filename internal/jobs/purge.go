@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/repositories"
+)
+
+// PurgeJob remove definitivamente os colaboradores e departamentos excluídos
+// logicamente há mais tempo que o período de retenção. O histórico de lotações
+// segue o registro: é apagado na mesma transação do expurgo. O log de auditoria
+// é mantido.
+type PurgeJob struct {
+	uow       *repositories.UnitOfWork
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewPurgeJob cria uma nova instância de PurgeJob
+func NewPurgeJob(uow *repositories.UnitOfWork, retention, interval time.Duration) *PurgeJob {
+	return &PurgeJob{uow: uow, retention: retention, interval: interval}
+}
+
+// RunOnce executa um expurgo tomando now como referência, em uma única transação.
+// Colaboradores vêm primeiro para liberar os departamentos que ainda os referenciam.
+func (j *PurgeJob) RunOnce(now time.Time) (colaboradores, departamentos int64, err error) {
+	before := now.Add(-j.retention)
+	err = j.uow.Do(func(tx *repositories.Repositories) error {
+		var err error
+		if colaboradores, err = tx.Colaboradores.PurgeDeleted(before); err != nil {
+			return err
+		}
+		departamentos, err = tx.Departamentos.PurgeDeleted(before)
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return colaboradores, departamentos, nil
+}
+
+// Start executa o expurgo imediatamente e depois a cada intervalo, até ctx ser cancelado.
+func (j *PurgeJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		colabs, depts, err := j.RunOnce(time.Now())
+		if err != nil {
+			log.Printf("warning: falha no expurgo de registros excluídos: %v", err)
+		} else if colabs > 0 || depts > 0 {
+			log.Printf("expurgo: %d colaboradores e %d departamentos removidos", colabs, depts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/services"
+)
+
+// TransferJob efetiva as transferências agendadas cuja data já chegou,
+// atualizando o departamento atual dos colaboradores. Cada transferência fica na
+// auditoria com o ator do sistema.
+type TransferJob struct {
+	service  *services.ColaboradorService
+	interval time.Duration
+}
+
+// NewTransferJob cria uma nova instância de TransferJob
+func NewTransferJob(s *services.ColaboradorService, interval time.Duration) *TransferJob {
+	return &TransferJob{service: s, interval: interval}
+}
+
+// RunOnce efetiva as transferências vigentes na data de now (UTC).
+func (j *TransferJob) RunOnce(ctx context.Context, now time.Time) (int64, error) {
+	return j.service.ApplyDueTransfers(ctx, now.UTC().Truncate(24*time.Hour))
+}
+
+// Start executa o job imediatamente e depois a cada intervalo, até ctx ser cancelado.
+func (j *TransferJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		applied, err := j.RunOnce(ctx, time.Now())
+		if err != nil {
+			log.Printf("warning: falha ao efetivar transferências agendadas: %v", err)
+		} else if applied > 0 {
+			log.Printf("transferências: %d colaboradores transferidos", applied)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,33 +1,193 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Situações do vínculo empregatício do colaborador.
+const (
+	StatusAtivo     = "ativo"
+	StatusAfastado  = "afastado"
+	StatusDesligado = "desligado"
 )
 
 type Colaborador struct {
-	ID             uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"`
-	Nome           string    `gorm:"not null" json:"nome"`
-	CPF            string    `gorm:"size:11;not null;uniqueIndex" json:"cpf"`
-	RG             *string   `gorm:"size:50;uniqueIndex" json:"rg,omitempty"`
-	DepartamentoID uuid.UUID `gorm:"type:uuid;not null" json:"departamento_id"`
+	ID                 uuid.UUID      `gorm:"type:uuid;primaryKey" json:"id"`
+	Nome               string         `gorm:"not null" json:"nome"`
+	CPF                string         `gorm:"size:11;not null;uniqueIndex" json:"cpf"`
+	RG                 *string        `gorm:"size:50;uniqueIndex" json:"rg,omitempty"`
+	Email              *string        `gorm:"size:255;uniqueIndex" json:"email,omitempty"`
+	EmailPessoal       *string        `gorm:"size:255" json:"email_pessoal,omitempty"`
+	Telefone           *string        `gorm:"size:20" json:"telefone,omitempty"`
+	Celular            *string        `gorm:"size:20" json:"celular,omitempty"`
+	DepartamentoID     uuid.UUID      `gorm:"type:uuid;not null" json:"departamento_id"`
+	CargoID            *uuid.UUID     `gorm:"type:uuid;index" json:"cargo_id,omitempty"`
+	Status             string         `gorm:"size:20;not null;default:ativo;index" json:"status" example:"ativo"`
+	DataAdmissao       *time.Time     `gorm:"type:date" json:"data_admissao,omitempty" example:"2024-03-01T00:00:00Z"`
+	DataDesligamento   *time.Time     `gorm:"type:date" json:"data_desligamento,omitempty"`
+	MotivoDesligamento *string        `gorm:"size:255" json:"motivo_desligamento,omitempty"`
+	Version            int            `gorm:"not null;default:1" json:"version" example:"1"`
+	CreatedAt          time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string" format:"date-time"`
+}
+
+type Departamento struct {
+	ID                     uuid.UUID      `gorm:"type:uuid;primaryKey" json:"id"`
+	Nome                   string         `gorm:"not null" json:"nome"`
+	Descricao              *string        `gorm:"type:text" json:"descricao,omitempty"`
+	GerenteID              *uuid.UUID     `gorm:"type:uuid" json:"gerente_id,omitempty"`
+	DepartamentoSuperiorID *uuid.UUID     `gorm:"type:uuid" json:"departamento_superior_id,omitempty"`
+	Version                int            `gorm:"not null;default:1" json:"version" example:"1"`
+	CreatedAt              time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt              time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt              gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string" format:"date-time"`
+
+	// relations (for preload)
+	Gerente *Colaborador `gorm:"foreignKey:GerenteID" json:"gerente,omitempty"`
+}
+
+// Cargo é uma entrada do catálogo de cargos. CBO é o código da Classificação
+// Brasileira de Ocupações (6 dígitos) e SalarioMin/SalarioMax formam a faixa salarial.
+type Cargo struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"`
+	Titulo     string    `gorm:"size:100;not null;uniqueIndex" json:"titulo"`
+	Nivel      *string   `gorm:"size:30" json:"nivel,omitempty"`
+	CBO        *string   `gorm:"size:6" json:"cbo,omitempty"`
+	SalarioMin *float64  `gorm:"type:numeric(12,2)" json:"salario_min,omitempty"`
+	SalarioMax *float64  `gorm:"type:numeric(12,2)" json:"salario_max,omitempty"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// DepartamentoCargo indica quantas vagas em aberto um departamento tem para um cargo.
+type DepartamentoCargo struct {
+	DepartamentoID uuid.UUID `gorm:"type:uuid;primaryKey" json:"departamento_id"`
+	CargoID        uuid.UUID `gorm:"type:uuid;primaryKey" json:"cargo_id"`
+	Vagas          int       `gorm:"not null;default:0" json:"vagas"`
 	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt      time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Cargo *Cargo `gorm:"foreignKey:CargoID" json:"cargo,omitempty"`
 }
 
-type Departamento struct {
+// ColaboradorLotacao é um período em que o colaborador esteve lotado em um
+// departamento. O intervalo é [ValidFrom, ValidTo): ValidTo é o primeiro dia fora
+// do departamento e fica nil enquanto a lotação estiver em aberto. Lotações com
+// ValidFrom no futuro são transferências agendadas.
+type ColaboradorLotacao struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	ColaboradorID  uuid.UUID  `gorm:"type:uuid;not null;index" json:"colaborador_id"`
+	DepartamentoID uuid.UUID  `gorm:"type:uuid;not null;index" json:"departamento_id"`
+	ValidFrom      time.Time  `gorm:"type:date;not null" json:"valid_from"`
+	ValidTo        *time.Time `gorm:"type:date" json:"valid_to,omitempty"`
+	Motivo         *string    `gorm:"size:255" json:"motivo,omitempty"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// DepartamentoVersao é o estado de um departamento no intervalo [ValidFrom, ValidTo).
+// As versões são gravadas pelo banco (gatilho em departamentos) a cada alteração.
+type DepartamentoVersao struct {
 	ID                     uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
-	Nome                   string     `gorm:"not null" json:"nome"`
+	DepartamentoID         uuid.UUID  `gorm:"type:uuid;not null;index" json:"departamento_id"`
+	Nome                   string     `gorm:"size:100;not null" json:"nome"`
 	Descricao              *string    `gorm:"type:text" json:"descricao,omitempty"`
 	GerenteID              *uuid.UUID `gorm:"type:uuid" json:"gerente_id,omitempty"`
 	DepartamentoSuperiorID *uuid.UUID `gorm:"type:uuid" json:"departamento_superior_id,omitempty"`
-	CreatedAt              time.Time  `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt              time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+	ValidFrom              time.Time  `gorm:"not null" json:"valid_from"`
+	ValidTo                *time.Time `json:"valid_to,omitempty"`
+}
 
-	// relations (for preload)
-	Gerente *Colaborador `gorm:"foreignKey:GerenteID" json:"gerente,omitempty"`
+// DepartamentoExclusao registra cada tentativa de exclusão de departamento,
+// inclusive as recusadas, com a estratégia usada e o que foi afetado.
+type DepartamentoExclusao struct {
+	ID                       uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	DepartamentoID           uuid.UUID  `gorm:"type:uuid;not null;index" json:"departamento_id"`
+	Nome                     string     `gorm:"not null" json:"nome"`
+	Estrategia               string     `gorm:"size:20;not null" json:"estrategia"`
+	DestinoID                *uuid.UUID `gorm:"type:uuid" json:"destino_id,omitempty"`
+	Resultado                string     `gorm:"size:20;not null" json:"resultado"`
+	ColaboradoresAfetados    int64      `gorm:"not null;default:0" json:"colaboradores_afetados"`
+	SubdepartamentosAfetados int64      `gorm:"not null;default:0" json:"subdepartamentos_afetados"`
+	CreatedAt                time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// Operações registradas na trilha de auditoria.
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+)
+
+// Entidades registradas na trilha de auditoria.
+const (
+	AuditColaborador  = "colaborador"
+	AuditDepartamento = "departamento"
+)
+
+// AuditChange é o valor anterior e o novo de um campo alterado.
+type AuditChange struct {
+	De   interface{} `json:"de"`
+	Para interface{} `json:"para"`
+}
+
+// AuditChanges são os campos alterados, indexados pelo nome do campo na API.
+// É gravado como JSONB.
+type AuditChanges map[string]AuditChange
+
+// Value implementa driver.Valuer.
+func (a AuditChanges) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implementa sql.Scanner.
+func (a *AuditChanges) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("audit changes: tipo não suportado %T", src)
+	}
+}
+
+// AuditLog é uma entrada da trilha de auditoria: quem alterou qual entidade,
+// em qual requisição, e o que mudou.
+type AuditLog struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primaryKey" json:"id"`
+	Ator          string       `gorm:"size:100;not null" json:"ator" example:"admin"`
+	AtorDeclarado *string      `gorm:"size:100" json:"ator_declarado,omitempty" example:"maria.souza"`
+	RequestID     *string      `gorm:"size:64" json:"request_id,omitempty" example:"5f0c9e0e-3c4b-4b7e-9d59-8a3f0f1b2c3d"`
+	Entidade      string       `gorm:"size:30;not null" json:"entidade" example:"colaborador"`
+	EntidadeID    uuid.UUID    `gorm:"type:uuid;not null" json:"entidade_id"`
+	Operacao      string       `gorm:"size:20;not null" json:"operacao" example:"update"`
+	Alteracoes    AuditChanges `gorm:"type:jsonb;not null" json:"alteracoes" swaggertype:"object"`
+	CreatedAt     time.Time    `gorm:"autoCreateTime" json:"created_at"`
 }
 
-func (Colaborador) TableName() string  { return "colaboradores" }
-func (Departamento) TableName() string { return "departamentos" }
+func (Colaborador) TableName() string          { return "colaboradores" }
+func (Departamento) TableName() string         { return "departamentos" }
+func (DepartamentoExclusao) TableName() string { return "departamento_exclusoes" }
+func (Cargo) TableName() string                { return "cargos" }
+func (DepartamentoCargo) TableName() string    { return "departamento_cargos" }
+func (ColaboradorLotacao) TableName() string   { return "colaborador_lotacoes" }
+func (DepartamentoVersao) TableName() string   { return "departamento_versoes" }
+func (AuditLog) TableName() string             { return "audit_log" }
@@ -0,0 +1,121 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor é retornado quando o cursor recebido não pode ser decodificado.
+var ErrInvalidCursor = errors.New("cursor inválido")
+
+// Cursor identifica a posição de um registro na ordenação (created_at, id).
+// Para o cliente ele é opaco: sempre trafega codificado por Encode.
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+	Desc      bool      `json:"d,omitempty"`
+}
+
+// Encode serializa o cursor em base64 url-safe.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor faz o caminho inverso de Encode.
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// CursorParams agrupa os parâmetros da paginação por cursor (keyset).
+type CursorParams struct {
+	Limit     int
+	After     *Cursor
+	Desc      bool
+	WithTotal bool
+}
+
+// Normalize aplica o limite padrão/máximo e herda a direção do cursor recebido.
+func (p CursorParams) Normalize() CursorParams {
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+	if p.After != nil {
+		p.Desc = p.After.Desc
+	}
+	return p
+}
+
+// CursorMeta descreve a página retornada no modo cursor.
+type CursorMeta struct {
+	Limit      int    `json:"limit" example:"20"`
+	Total      *int64 `json:"total,omitempty" example:"42"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// CursorLinks contém as URLs de navegação no modo cursor.
+type CursorLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+}
+
+// CursorResponse é o envelope das listagens paginadas por cursor.
+type CursorResponse[T any] struct {
+	Data  []T         `json:"data"`
+	Meta  CursorMeta  `json:"meta"`
+	Links CursorLinks `json:"links"`
+}
+
+// NewCursorResponse monta o envelope do modo cursor. next é nil quando não há
+// mais registros; total é nil quando a contagem não foi solicitada.
+func NewCursorResponse[T any](data []T, next *Cursor, total *int64, p CursorParams, u *url.URL) CursorResponse[T] {
+	if data == nil {
+		data = []T{}
+	}
+
+	resp := CursorResponse[T]{
+		Data: data,
+		Meta: CursorMeta{
+			Limit:   p.Limit,
+			Total:   total,
+			HasMore: next != nil,
+		},
+	}
+	if u != nil {
+		resp.Links.Self = u.String()
+	}
+	if next != nil {
+		resp.Meta.NextCursor = next.Encode()
+		resp.Links.Next = cursorURL(u, resp.Meta.NextCursor)
+	}
+	return resp
+}
+
+func cursorURL(u *url.URL, cursor string) string {
+	if u == nil {
+		return ""
+	}
+	q := u.Query()
+	q.Del("page")
+	q.Del("sort")
+	q.Set("cursor", cursor)
+	link := url.URL{Path: u.Path, RawQuery: q.Encode()}
+	return link.String()
+}
@@ -0,0 +1,161 @@
+package pagination
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultLimit é o tamanho de página usado quando o cliente não informa limit.
+	DefaultLimit = 20
+	// MaxLimit é o maior tamanho de página aceito; valores acima são truncados.
+	MaxLimit = 100
+)
+
+// SortField representa uma coluna de ordenação já validada.
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// Params agrupa os parâmetros de paginação e ordenação de uma listagem.
+type Params struct {
+	Page  int
+	Limit int
+	Sort  []SortField
+}
+
+// Normalize aplica os valores padrão e o limite máximo de página.
+func (p Params) Normalize() Params {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+	return p
+}
+
+// Offset retorna o deslocamento correspondente à página atual.
+func (p Params) Offset() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// ParseSort interpreta uma lista separada por vírgulas como "nome,-created_at".
+// O prefixo "-" indica ordem decrescente e o sufixo ":asc"/":desc" também é aceito.
+// allowed mapeia o nome exposto na API para a coluna do banco.
+func ParseSort(raw string, allowed map[string]string) ([]SortField, error) {
+	var fields []SortField
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		desc := false
+		if strings.HasPrefix(part, "-") {
+			desc = true
+			part = part[1:]
+		} else if name, dir, ok := strings.Cut(part, ":"); ok {
+			switch strings.ToLower(dir) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("direção de ordenação inválida: %s", dir)
+			}
+			part = name
+		}
+
+		column, ok := allowed[part]
+		if !ok {
+			return nil, fmt.Errorf("campo de ordenação inválido: %s", part)
+		}
+		if seen[column] {
+			continue
+		}
+		seen[column] = true
+		fields = append(fields, SortField{Column: column, Desc: desc})
+	}
+	return fields, nil
+}
+
+// Meta descreve a página retornada.
+type Meta struct {
+	Page       int   `json:"page" example:"1"`
+	Limit      int   `json:"limit" example:"20"`
+	Total      int64 `json:"total" example:"42"`
+	TotalPages int   `json:"total_pages" example:"3"`
+}
+
+// Links contém as URLs de navegação entre páginas.
+type Links struct {
+	Self  string `json:"self"`
+	First string `json:"first"`
+	Last  string `json:"last"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
+// Response é o envelope padrão das listagens paginadas.
+type Response[T any] struct {
+	Data  []T   `json:"data"`
+	Meta  Meta  `json:"meta"`
+	Links Links `json:"links"`
+}
+
+// NewResponse monta o envelope a partir dos itens, do total e da URL da requisição.
+func NewResponse[T any](data []T, total int64, p Params, u *url.URL) Response[T] {
+	if data == nil {
+		data = []T{}
+	}
+
+	pages := 0
+	if p.Limit > 0 {
+		pages = int((total + int64(p.Limit) - 1) / int64(p.Limit))
+	}
+
+	last := pages
+	if last < 1 {
+		last = 1
+	}
+
+	links := Links{
+		Self:  pageURL(u, p.Page),
+		First: pageURL(u, 1),
+		Last:  pageURL(u, last),
+	}
+	if p.Page < pages {
+		links.Next = pageURL(u, p.Page+1)
+	}
+	if p.Page > 1 {
+		links.Prev = pageURL(u, min(p.Page-1, last))
+	}
+
+	return Response[T]{
+		Data: data,
+		Meta: Meta{
+			Page:       p.Page,
+			Limit:      p.Limit,
+			Total:      total,
+			TotalPages: pages,
+		},
+		Links: links,
+	}
+}
+
+func pageURL(u *url.URL, page int) string {
+	if u == nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	link := url.URL{Path: u.Path, RawQuery: q.Encode()}
+	return link.String()
+}
@@ -0,0 +1,70 @@
+package pagination
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSort(t *testing.T) {
+	allowed := map[string]string{"nome": "nome", "created_at": "created_at"}
+
+	fields, err := ParseSort("nome, -created_at", allowed)
+	require.NoError(t, err)
+	assert.Equal(t, []SortField{{Column: "nome"}, {Column: "created_at", Desc: true}}, fields)
+
+	fields, err = ParseSort("created_at:desc,nome:asc", allowed)
+	require.NoError(t, err)
+	assert.Equal(t, []SortField{{Column: "created_at", Desc: true}, {Column: "nome"}}, fields)
+
+	_, err = ParseSort("senha", allowed)
+	assert.Error(t, err)
+
+	_, err = ParseSort("nome:up", allowed)
+	assert.Error(t, err)
+}
+
+func TestNormalize(t *testing.T) {
+	p := Params{Page: 0, Limit: 5000}.Normalize()
+	assert.Equal(t, 1, p.Page)
+	assert.Equal(t, MaxLimit, p.Limit)
+
+	p = Params{}.Normalize()
+	assert.Equal(t, DefaultLimit, p.Limit)
+}
+
+func TestNewResponseLinks(t *testing.T) {
+	u, _ := url.Parse("/api/v1/colaboradores?nome=ana&page=2&limit=10")
+	resp := NewResponse([]string{"a"}, 25, Params{Page: 2, Limit: 10}, u)
+
+	assert.Equal(t, 3, resp.Meta.TotalPages)
+	assert.Equal(t, "/api/v1/colaboradores?limit=10&nome=ana&page=3", resp.Links.Next)
+	assert.Equal(t, "/api/v1/colaboradores?limit=10&nome=ana&page=1", resp.Links.Prev)
+	assert.Equal(t, "/api/v1/colaboradores?limit=10&nome=ana&page=3", resp.Links.Last)
+
+	empty := NewResponse[string](nil, 0, Params{Page: 1, Limit: 10}, u)
+	assert.NotNil(t, empty.Data)
+	assert.Empty(t, empty.Links.Next)
+	assert.Empty(t, empty.Links.Prev)
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	c := Cursor{CreatedAt: time.Date(2025, 3, 1, 10, 0, 0, 123456000, time.UTC), ID: uuid.New(), Desc: true}
+
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	assert.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, c.ID, decoded.ID)
+	assert.True(t, decoded.Desc)
+
+	_, err = DecodeCursor("not-a-cursor")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	p := CursorParams{After: decoded}.Normalize()
+	assert.True(t, p.Desc)
+	assert.Equal(t, DefaultLimit, p.Limit)
+}
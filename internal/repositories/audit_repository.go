@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AuditRepository grava e consulta a trilha de auditoria.
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// WithTx retorna uma cópia do repositório que executa dentro da transação tx.
+func (r *AuditRepository) WithTx(tx *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: tx}
+}
+
+// Create grava uma entrada de auditoria.
+func (r *AuditRepository) Create(e *models.AuditLog) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return r.db.Create(e).Error
+}
+
+// AuditSortFields mapeia os campos ordenáveis da API para colunas.
+var AuditSortFields = map[string]string{
+	"created_at": "created_at",
+	"ator":       "ator",
+	"entidade":   "entidade",
+	"operacao":   "operacao",
+}
+
+// List retorna as entradas que atendem aos filtros, por padrão das mais recentes
+// para as mais antigas.
+func (r *AuditRepository) List(filters map[string]interface{}, p pagination.Params) ([]models.AuditLog, int64, error) {
+	var list []models.AuditLog
+	query := applyAuditFilters(r.db.Model(&models.AuditLog{}), filters)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	p = p.Normalize()
+	query = applySort(query, p.Sort, pagination.SortField{Column: "created_at", Desc: true})
+	if err := query.Offset(p.Offset()).Limit(p.Limit).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func applyAuditFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
+	if v, ok := filters["entidade"].(string); ok && v != "" {
+		query = query.Where("entidade = ?", v)
+	}
+	if v, ok := filters["entidade_id"].(string); ok && v != "" {
+		query = query.Where("entidade_id = ?", v)
+	}
+	if v, ok := filters["ator"].(string); ok && v != "" {
+		query = query.Where("ator = ?", v)
+	}
+	if v, ok := filters["ator_declarado"].(string); ok && v != "" {
+		query = query.Where("ator_declarado = ?", v)
+	}
+	if v, ok := filters["operacao"].(string); ok && v != "" {
+		query = query.Where("operacao = ?", v)
+	}
+	if v, ok := filters["request_id"].(string); ok && v != "" {
+		query = query.Where("request_id = ?", v)
+	}
+	if v, ok := filters["from"].(time.Time); ok {
+		query = query.Where("created_at >= ?", v)
+	}
+	if v, ok := filters["to"].(time.Time); ok {
+		query = query.Where("created_at < ?", v)
+	}
+	return query
+}
@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CargoRepository struct {
+	db *gorm.DB
+}
+
+func NewCargoRepository(db *gorm.DB) *CargoRepository {
+	return &CargoRepository{db: db}
+}
+
+// WithTx retorna uma cópia do repositório que executa dentro da transação tx.
+func (r *CargoRepository) WithTx(tx *gorm.DB) *CargoRepository {
+	return &CargoRepository{db: tx}
+}
+
+func (r *CargoRepository) Create(c *models.Cargo) error {
+	return r.db.Create(c).Error
+}
+
+func (r *CargoRepository) GetByID(id uuid.UUID) (*models.Cargo, error) {
+	var c models.Cargo
+	if err := r.db.First(&c, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *CargoRepository) GetByTitulo(titulo string) (*models.Cargo, error) {
+	var c models.Cargo
+	if err := r.db.First(&c, "LOWER(titulo) = LOWER(?)", titulo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *CargoRepository) Update(c *models.Cargo) error {
+	return r.db.Save(c).Error
+}
+
+func (r *CargoRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Cargo{}, "id = ?", id).Error
+}
+
+// CountColaboradores retorna quantos colaboradores ocupam o cargo, inclusive os
+// desligados e os excluídos logicamente, que ainda referenciam o cargo.
+func (r *CargoRepository) CountColaboradores(id uuid.UUID) (int64, error) {
+	var total int64
+	err := r.db.Unscoped().Model(&models.Colaborador{}).Where("cargo_id = ?", id).Count(&total).Error
+	return total, err
+}
+
+// CargoSortFields mapeia os campos ordenáveis da API para colunas.
+var CargoSortFields = map[string]string{
+	"titulo":      "titulo",
+	"nivel":       "nivel",
+	"cbo":         "cbo",
+	"salario_min": "salario_min",
+	"salario_max": "salario_max",
+	"created_at":  "created_at",
+}
+
+func (r *CargoRepository) List(filters map[string]interface{}, p pagination.Params) ([]models.Cargo, int64, error) {
+	var list []models.Cargo
+	query := r.db.Model(&models.Cargo{})
+	if v, ok := filters["titulo"].(string); ok && v != "" {
+		query = query.Where("titulo ILIKE ?", "%"+v+"%")
+	}
+	if v, ok := filters["nivel"].(string); ok && v != "" {
+		query = query.Where("nivel = ?", v)
+	}
+	if v, ok := filters["cbo"].(string); ok && v != "" {
+		query = query.Where("cbo = ?", v)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	p = p.Normalize()
+	query = applySort(query, p.Sort, pagination.SortField{Column: "titulo"})
+	if err := query.Offset(p.Offset()).Limit(p.Limit).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// ListVagas retorna os cargos com vagas definidas para o departamento, com o cargo carregado.
+func (r *CargoRepository) ListVagas(departamentoID uuid.UUID) ([]models.DepartamentoCargo, error) {
+	var list []models.DepartamentoCargo
+	if err := r.db.Preload("Cargo").Where("departamento_id = ?", departamentoID).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetVagas cria ou atualiza a quantidade de vagas do cargo no departamento.
+func (r *CargoRepository) SetVagas(dc *models.DepartamentoCargo) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "departamento_id"}, {Name: "cargo_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"vagas", "updated_at"}),
+	}).Create(dc).Error
+}
+
+// DeleteVagas remove o cargo da lista de vagas do departamento.
+func (r *CargoRepository) DeleteVagas(departamentoID, cargoID uuid.UUID) (int64, error) {
+	res := r.db.Delete(&models.DepartamentoCargo{}, "departamento_id = ? AND cargo_id = ?", departamentoID, cargoID)
+	return res.RowsAffected, res.Error
+}
+
+// CountOcupados retorna, por cargo, quantos colaboradores não desligados do departamento o ocupam.
+func (r *CargoRepository) CountOcupados(departamentoID uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		CargoID uuid.UUID
+		Total   int64
+	}
+	if err := r.db.Model(&models.Colaborador{}).
+		Select("cargo_id, COUNT(*) AS total").
+		Where("departamento_id = ? AND cargo_id IS NOT NULL AND status <> ?", departamentoID, models.StatusDesligado).
+		Group("cargo_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.CargoID] = row.Total
+	}
+	return counts, nil
+}
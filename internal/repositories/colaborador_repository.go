@@ -2,10 +2,15 @@ package repositories
 
 import (
 	"errors"
+	"strings"
+	"time"
 
+	"github.com/danubiobwm/company-api/internal/cpf"
 	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ColaboradorRepository struct {
@@ -20,6 +25,40 @@ func (r *ColaboradorRepository) DB() *gorm.DB {
 	return r.db
 }
 
+// WithTx retorna uma cópia do repositório que executa dentro da transação tx.
+func (r *ColaboradorRepository) WithTx(tx *gorm.DB) *ColaboradorRepository {
+	return &ColaboradorRepository{db: tx}
+}
+
+// DeleteByDepartamentos remove todos os colaboradores lotados nos departamentos informados.
+func (r *ColaboradorRepository) DeleteByDepartamentos(ids []uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := r.db.Delete(&models.Colaborador{}, "departamento_id IN ?", ids)
+	return res.RowsAffected, res.Error
+}
+
+// FindByDepartamentos retorna todos os colaboradores dos departamentos informados,
+// inclusive os desligados.
+func (r *ColaboradorRepository) FindByDepartamentos(ids []uuid.UUID) ([]models.Colaborador, error) {
+	var list []models.Colaborador
+	if len(ids) == 0 {
+		return list, nil
+	}
+	if err := r.db.Where("departamento_id IN ?", ids).Order("nome").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ReassignDepartamento move todos os colaboradores de from para to.
+func (r *ColaboradorRepository) ReassignDepartamento(from, to uuid.UUID) (int64, error) {
+	res := r.db.Model(&models.Colaborador{}).Where("departamento_id = ?", from).
+		Updates(map[string]interface{}{"departamento_id": to, "version": gorm.Expr("version + 1")})
+	return res.RowsAffected, res.Error
+}
+
 func (r *ColaboradorRepository) Create(c *models.Colaborador) error {
 	return r.db.Create(c).Error
 }
@@ -35,9 +74,63 @@ func (r *ColaboradorRepository) GetByID(id uuid.UUID) (*models.Colaborador, erro
 	return &c, nil
 }
 
-func (r *ColaboradorRepository) GetByCPF(cpf string) (*models.Colaborador, error) {
+// GetByIDForUpdate retorna o colaborador por id e o bloqueia até o fim da
+// transação corrente, para que a versão lida não mude antes da gravação.
+func (r *ColaboradorRepository) GetByIDForUpdate(id uuid.UUID) (*models.Colaborador, error) {
+	var c models.Colaborador
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&c, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// GetByIDUnscoped retorna o colaborador por id mesmo que tenha sido excluído logicamente.
+func (r *ColaboradorRepository) GetByIDUnscoped(id uuid.UUID) (*models.Colaborador, error) {
 	var c models.Colaborador
-	if err := r.db.First(&c, "cpf = ?", cpf).Error; err != nil {
+	if err := r.db.Unscoped().First(&c, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Restore desfaz a exclusão lógica do colaborador.
+func (r *ColaboradorRepository) Restore(id uuid.UUID) error {
+	return r.db.Unscoped().Model(&models.Colaborador{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")}).Error
+}
+
+// PurgeDeleted remove definitivamente os colaboradores excluídos logicamente antes
+// de before, junto com o histórico de lotações deles. Deve rodar dentro de uma
+// transação (UnitOfWork.Do) para que histórico e colaborador saiam juntos.
+func (r *ColaboradorRepository) PurgeDeleted(before time.Time) (int64, error) {
+	var ids []uuid.UUID
+	err := r.db.Unscoped().Model(&models.Colaborador{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	if err := r.db.Delete(&models.ColaboradorLotacao{}, "colaborador_id IN ?", ids).Error; err != nil {
+		return 0, err
+	}
+	res := r.db.Unscoped().Delete(&models.Colaborador{}, "id IN ?", ids)
+	return res.RowsAffected, res.Error
+}
+
+// GetByCPF, GetByRG e GetByEmail também enxergam colaboradores excluídos
+// logicamente, que continuam ocupando os índices únicos. GetByCPF aceita o CPF
+// com ou sem pontuação.
+func (r *ColaboradorRepository) GetByCPF(number string) (*models.Colaborador, error) {
+	var c models.Colaborador
+	if err := r.db.Unscoped().First(&c, "cpf = ?", cpf.Normalize(number)).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -48,7 +141,7 @@ func (r *ColaboradorRepository) GetByCPF(cpf string) (*models.Colaborador, error
 
 func (r *ColaboradorRepository) GetByRG(rg string) (*models.Colaborador, error) {
 	var c models.Colaborador
-	if err := r.db.First(&c, "rg = ?", rg).Error; err != nil {
+	if err := r.db.Unscoped().First(&c, "rg = ?", rg).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -57,41 +150,165 @@ func (r *ColaboradorRepository) GetByRG(rg string) (*models.Colaborador, error)
 	return &c, nil
 }
 
+func (r *ColaboradorRepository) GetByEmail(email string) (*models.Colaborador, error) {
+	var c models.Colaborador
+	if err := r.db.Unscoped().First(&c, "email = ?", email).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Update grava todos os campos de c desde que a versão no banco ainda seja
+// c.Version, e incrementa a versão. Se outra gravação chegou antes, retorna
+// ErrVersionConflict e c fica inalterado.
 func (r *ColaboradorRepository) Update(c *models.Colaborador) error {
-	return r.db.Save(c).Error
+	expected := c.Version
+	c.Version = expected + 1
+	res := r.db.Model(c).Where("version = ?", expected).Select("*").Omit("created_at").Updates(c)
+	if res.Error == nil && res.RowsAffected == 0 {
+		res.Error = ErrVersionConflict
+	}
+	if res.Error != nil {
+		c.Version = expected
+	}
+	return res.Error
 }
 
+// Delete exclui logicamente (preenche deleted_at); PurgeDeleted remove de vez.
 func (r *ColaboradorRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.Colaborador{}, "id = ?", id).Error
 }
 
-func (r *ColaboradorRepository) List(filters map[string]interface{}, page, limit int) ([]models.Colaborador, int64, error) {
+// ColaboradorSortFields mapeia os campos ordenáveis da API para colunas.
+var ColaboradorSortFields = map[string]string{
+	"nome":            "nome",
+	"cpf":             "cpf",
+	"rg":              "rg",
+	"email":           "email",
+	"departamento_id": "departamento_id",
+	"cargo_id":        "cargo_id",
+	"created_at":      "created_at",
+	"updated_at":      "updated_at",
+}
+
+func (r *ColaboradorRepository) List(filters map[string]interface{}, p pagination.Params) ([]models.Colaborador, int64, error) {
+	var list []models.Colaborador
+	query := applyColaboradorFilters(r.db.Model(&models.Colaborador{}), filters)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	p = p.Normalize()
+	query = applySort(query, p.Sort, pagination.SortField{Column: "nome"})
+	if err := query.Offset(p.Offset()).Limit(p.Limit).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// ListByCursor lista colaboradores em ordem (created_at, id) a partir do cursor,
+// sem OFFSET. Retorna o cursor da próxima página, ou nil se não houver mais registros.
+func (r *ColaboradorRepository) ListByCursor(filters map[string]interface{}, p pagination.CursorParams) ([]models.Colaborador, *pagination.Cursor, error) {
 	var list []models.Colaborador
-	query := r.db.Model(&models.Colaborador{})
+	p = p.Normalize()
+	query := applyColaboradorFilters(r.db.Model(&models.Colaborador{}), filters)
+	query = applyKeyset(query, p)
+	if err := query.Limit(p.Limit + 1).Find(&list).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(list) <= p.Limit {
+		return list, nil, nil
+	}
+	list = list[:p.Limit]
+	last := list[len(list)-1]
+	return list, &pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID, Desc: p.Desc}, nil
+}
+
+// Count retorna a quantidade de colaboradores que atendem aos filtros.
+func (r *ColaboradorRepository) Count(filters map[string]interface{}) (int64, error) {
+	var total int64
+	err := applyColaboradorFilters(r.db.Model(&models.Colaborador{}), filters).Count(&total).Error
+	return total, err
+}
+
+func applyColaboradorFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
+	if v, ok := filters["include_deleted"].(bool); ok && v {
+		query = query.Unscoped()
+	}
 	if v, ok := filters["nome"].(string); ok && v != "" {
 		query = query.Where("nome ILIKE ?", "%"+v+"%")
 	}
 	if v, ok := filters["cpf"].(string); ok && v != "" {
-		query = query.Where("cpf = ?", v)
+		query = query.Where("cpf = ?", cpf.Normalize(v))
 	}
 	if v, ok := filters["rg"].(string); ok && v != "" {
 		query = query.Where("rg = ?", v)
 	}
+	if v, ok := filters["email"].(string); ok && v != "" {
+		query = query.Where("email = ? OR email_pessoal = ?", strings.ToLower(v), strings.ToLower(v))
+	}
+	// com as_of, os filtros de departamento usam a lotação vigente naquela data
+	asOf, historical := filters["as_of"].(time.Time)
+	lotados := "id IN (SELECT colaborador_id FROM colaborador_lotacoes WHERE departamento_id IN ? AND " + vigenteEm + ")"
 	if v, ok := filters["departamento_id"].(string); ok && v != "" {
-		query = query.Where("departamento_id = ?", v)
+		if historical {
+			query = query.Where(lotados, []string{v}, asOf, asOf)
+		} else {
+			query = query.Where("departamento_id = ?", v)
+		}
 	}
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if v, ok := filters["cargo_id"].(string); ok && v != "" {
+		query = query.Where("cargo_id = ?", v)
 	}
-	if page < 1 {
-		page = 1
+	if v, ok := filters["departamento_ids"].([]uuid.UUID); ok {
+		if historical {
+			query = query.Where(lotados, v, asOf, asOf)
+		} else {
+			query = query.Where("departamento_id IN ?", v)
+		}
 	}
-	if limit <= 0 {
-		limit = 10
+	// Sem filtro de status, desligados ficam de fora a menos que include_desligados seja
+	// true. Com as_of a lotação da data já exclui quem ainda não tinha sido admitido ou
+	// já tinha sido desligado.
+	if v, ok := filters["status"].(string); ok && v != "" {
+		query = query.Where("status = ?", v)
+	} else if include, _ := filters["include_desligados"].(bool); !include && !historical {
+		query = query.Where("status <> ?", models.StatusDesligado)
 	}
-	if err := query.Offset((page - 1) * limit).Limit(limit).Find(&list).Error; err != nil {
-		return nil, 0, err
+	return query
+}
+
+// CountByDepartamento retorna a quantidade de colaboradores não desligados de cada departamento.
+func (r *ColaboradorRepository) CountByDepartamento() (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		DepartamentoID uuid.UUID
+		Total          int64
 	}
-	return list, total, nil
+	if err := r.db.Model(&models.Colaborador{}).
+		Select("departamento_id, COUNT(*) AS total").
+		Where("status <> ?", models.StatusDesligado).
+		Group("departamento_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DepartamentoID] = row.Total
+	}
+	return counts, nil
+}
+
+// ListByDepartamentos retorna os colaboradores não desligados lotados em qualquer um dos departamentos informados.
+func (r *ColaboradorRepository) ListByDepartamentos(ids []uuid.UUID) ([]models.Colaborador, error) {
+	var list []models.Colaborador
+	if len(ids) == 0 {
+		return list, nil
+	}
+	if err := r.db.Where("departamento_id IN ? AND status <> ?", ids, models.StatusDesligado).Order("nome").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
 }
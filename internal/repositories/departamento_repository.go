@@ -1,9 +1,13 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type DepartamentoRepository struct {
@@ -14,6 +18,34 @@ func NewDepartamentoRepository(db *gorm.DB) *DepartamentoRepository {
 	return &DepartamentoRepository{db: db}
 }
 
+func (r *DepartamentoRepository) DB() *gorm.DB {
+	return r.db
+}
+
+// WithTx retorna uma cópia do repositório que executa dentro da transação tx.
+func (r *DepartamentoRepository) WithTx(tx *gorm.DB) *DepartamentoRepository {
+	return &DepartamentoRepository{db: tx}
+}
+
+// LockHierarchy serializa alterações de hierarquia até o fim da transação corrente,
+// evitando que duas mudanças concorrentes validadas isoladamente formem um ciclo.
+func (r *DepartamentoRepository) LockHierarchy() error {
+	return r.db.Exec("SELECT pg_advisory_xact_lock(hashtext('departamentos_hierarquia'))").Error
+}
+
+// SetSuperior altera apenas o departamento superior de id.
+func (r *DepartamentoRepository) SetSuperior(id uuid.UUID, parentID *uuid.UUID) error {
+	return r.db.Model(&models.Departamento{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"departamento_superior_id": parentID, "version": gorm.Expr("version + 1")}).Error
+}
+
+// ReassignChildren move os subdepartamentos diretos de from para to.
+func (r *DepartamentoRepository) ReassignChildren(from, to uuid.UUID) (int64, error) {
+	res := r.db.Model(&models.Departamento{}).Where("departamento_superior_id = ?", from).
+		Updates(map[string]interface{}{"departamento_superior_id": to, "version": gorm.Expr("version + 1")})
+	return res.RowsAffected, res.Error
+}
+
 func (r *DepartamentoRepository) FindAll() ([]models.Departamento, error) {
 	var departamentos []models.Departamento
 	if err := r.db.Preload("Gerente").Find(&departamentos).Error; err != nil {
@@ -22,6 +54,50 @@ func (r *DepartamentoRepository) FindAll() ([]models.Departamento, error) {
 	return departamentos, nil
 }
 
+// FindAllAsOf reconstrói os departamentos como estavam no instante at, a partir
+// das versões temporais, com o gerente da época carregado.
+func (r *DepartamentoRepository) FindAllAsOf(at time.Time) ([]models.Departamento, error) {
+	var versoes []models.DepartamentoVersao
+	if err := r.db.
+		Where("valid_from <= ? AND (valid_to IS NULL OR valid_to > ?)", at, at).
+		Find(&versoes).Error; err != nil {
+		return nil, err
+	}
+
+	gerenteIDs := make([]uuid.UUID, 0, len(versoes))
+	for _, v := range versoes {
+		if v.GerenteID != nil {
+			gerenteIDs = append(gerenteIDs, *v.GerenteID)
+		}
+	}
+	gerentes := make(map[uuid.UUID]*models.Colaborador, len(gerenteIDs))
+	if len(gerenteIDs) > 0 {
+		var list []models.Colaborador
+		if err := r.db.Unscoped().Where("id IN ?", gerenteIDs).Find(&list).Error; err != nil {
+			return nil, err
+		}
+		for i := range list {
+			gerentes[list[i].ID] = &list[i]
+		}
+	}
+
+	depts := make([]models.Departamento, 0, len(versoes))
+	for _, v := range versoes {
+		d := models.Departamento{
+			ID:                     v.DepartamentoID,
+			Nome:                   v.Nome,
+			Descricao:              v.Descricao,
+			GerenteID:              v.GerenteID,
+			DepartamentoSuperiorID: v.DepartamentoSuperiorID,
+		}
+		if v.GerenteID != nil {
+			d.Gerente = gerentes[*v.GerenteID]
+		}
+		depts = append(depts, d)
+	}
+	return depts, nil
+}
+
 func (r *DepartamentoRepository) GetByID(id uuid.UUID) (*models.Departamento, error) {
 	var dept models.Departamento
 	if err := r.db.Preload("Gerente").First(&dept, "id = ?", id).Error; err != nil {
@@ -33,14 +109,319 @@ func (r *DepartamentoRepository) GetByID(id uuid.UUID) (*models.Departamento, er
 	return &dept, nil
 }
 
+// GetByIDForUpdate retorna o departamento por id e o bloqueia até o fim da
+// transação corrente, para que a versão lida não mude antes da gravação.
+func (r *DepartamentoRepository) GetByIDForUpdate(id uuid.UUID) (*models.Departamento, error) {
+	var dept models.Departamento
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&dept, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &dept, nil
+}
+
+// GetByIDUnscoped retorna o departamento por id mesmo que tenha sido excluído logicamente.
+func (r *DepartamentoRepository) GetByIDUnscoped(id uuid.UUID) (*models.Departamento, error) {
+	var dept models.Departamento
+	if err := r.db.Unscoped().Preload("Gerente").First(&dept, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &dept, nil
+}
+
+// Restore desfaz a exclusão lógica do departamento.
+func (r *DepartamentoRepository) Restore(id uuid.UUID) error {
+	return r.db.Unscoped().Model(&models.Departamento{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")}).Error
+}
+
+// PurgeDeleted remove definitivamente os departamentos excluídos logicamente antes
+// de before, junto com as lotações que apontam para eles. Departamentos ainda
+// referenciados por algum colaborador, mesmo excluído, ficam para uma próxima
+// execução. Deve rodar dentro de uma transação (UnitOfWork.Do).
+func (r *DepartamentoRepository) PurgeDeleted(before time.Time) (int64, error) {
+	var ids []uuid.UUID
+	err := r.db.Unscoped().Model(&models.Departamento{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Where("NOT EXISTS (SELECT 1 FROM colaboradores c WHERE c.departamento_id = departamentos.id)").
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	if err := r.db.Delete(&models.ColaboradorLotacao{}, "departamento_id IN ?", ids).Error; err != nil {
+		return 0, err
+	}
+	res := r.db.Unscoped().Delete(&models.Departamento{}, "id IN ?", ids)
+	return res.RowsAffected, res.Error
+}
+
 func (r *DepartamentoRepository) Create(d *models.Departamento) error {
 	return r.db.Create(d).Error
 }
 
+// Update grava todos os campos de d desde que a versão no banco ainda seja
+// d.Version, e incrementa a versão. Se outra gravação chegou antes, retorna
+// ErrVersionConflict e d fica inalterado.
 func (r *DepartamentoRepository) Update(d *models.Departamento) error {
-	return r.db.Save(d).Error
+	expected := d.Version
+	d.Version = expected + 1
+	res := r.db.Model(d).Where("version = ?", expected).Select("*").Omit("created_at", "Gerente").Updates(d)
+	if res.Error == nil && res.RowsAffected == 0 {
+		res.Error = ErrVersionConflict
+	}
+	if res.Error != nil {
+		d.Version = expected
+	}
+	return res.Error
 }
 
+// Delete exclui logicamente (preenche deleted_at); PurgeDeleted remove de vez.
 func (r *DepartamentoRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&models.Departamento{}, "id = ?", id).Error
 }
+
+// DepartamentoSortFields mapeia os campos ordenáveis da API para colunas.
+var DepartamentoSortFields = map[string]string{
+	"nome":                     "nome",
+	"gerente_id":               "gerente_id",
+	"departamento_superior_id": "departamento_superior_id",
+	"created_at":               "created_at",
+	"updated_at":               "updated_at",
+}
+
+// List retorna uma página de departamentos. O gerente só é carregado quando preloadGerente é true.
+func (r *DepartamentoRepository) List(filters map[string]interface{}, p pagination.Params, preloadGerente bool) ([]models.Departamento, int64, error) {
+	var list []models.Departamento
+	query := applyDepartamentoFilters(r.db.Model(&models.Departamento{}), filters)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	p = p.Normalize()
+	query = applySort(query, p.Sort, pagination.SortField{Column: "nome"})
+	if preloadGerente {
+		query = query.Preload("Gerente")
+	}
+	if err := query.Offset(p.Offset()).Limit(p.Limit).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// ListByCursor lista departamentos em ordem (created_at, id) a partir do cursor.
+func (r *DepartamentoRepository) ListByCursor(filters map[string]interface{}, p pagination.CursorParams, preloadGerente bool) ([]models.Departamento, *pagination.Cursor, error) {
+	var list []models.Departamento
+	p = p.Normalize()
+	query := applyKeyset(applyDepartamentoFilters(r.db.Model(&models.Departamento{}), filters), p)
+	if preloadGerente {
+		query = query.Preload("Gerente")
+	}
+	if err := query.Limit(p.Limit + 1).Find(&list).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(list) <= p.Limit {
+		return list, nil, nil
+	}
+	list = list[:p.Limit]
+	last := list[len(list)-1]
+	return list, &pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID, Desc: p.Desc}, nil
+}
+
+// Count retorna a quantidade de departamentos que atendem aos filtros.
+func (r *DepartamentoRepository) Count(filters map[string]interface{}) (int64, error) {
+	var total int64
+	err := applyDepartamentoFilters(r.db.Model(&models.Departamento{}), filters).Count(&total).Error
+	return total, err
+}
+
+func applyDepartamentoFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
+	if v, ok := filters["include_deleted"].(bool); ok && v {
+		query = query.Unscoped()
+	}
+	if v, ok := filters["nome"].(string); ok && v != "" {
+		query = query.Where("nome ILIKE ?", "%"+v+"%")
+	}
+	if v, ok := filters["gerente_id"].(string); ok && v != "" {
+		query = query.Where("gerente_id = ?", v)
+	}
+	if v, ok := filters["departamento_superior_id"].(string); ok && v != "" {
+		query = query.Where("departamento_superior_id = ?", v)
+	}
+	if v, ok := filters["root"].(bool); ok && v {
+		query = query.Where("departamento_superior_id IS NULL")
+	}
+	return query
+}
+
+// maxHierarchyScan limita as consultas recursivas que não recebem um limite explícito.
+const maxHierarchyScan = 1000
+
+// AncestorIDs retorna os ids da cadeia de departamentos a partir de id (inclusive)
+// subindo por departamento_superior_id, do mais próximo ao mais distante.
+// A busca para após limit níveis (ou maxHierarchyScan se limit <= 0), o que também
+// protege contra ciclos já gravados.
+func (r *DepartamentoRepository) AncestorIDs(id uuid.UUID, limit int) ([]uuid.UUID, error) {
+	if limit <= 0 {
+		limit = maxHierarchyScan
+	}
+	var ids []uuid.UUID
+	sql := `
+	WITH RECURSIVE ancestors AS (
+		SELECT id, departamento_superior_id, 1 AS depth
+		FROM departamentos
+		WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT d.id, d.departamento_superior_id, a.depth + 1
+		FROM departamentos d
+		INNER JOIN ancestors a ON d.id = a.departamento_superior_id
+		WHERE a.depth < ? AND d.deleted_at IS NULL
+	)
+	SELECT id FROM ancestors ORDER BY depth;
+	`
+	if err := r.db.Raw(sql, id, limit).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SubtreeHeight retorna quantos níveis a subárvore de id ocupa (1 quando não há filhos),
+// limitado a limit níveis.
+func (r *DepartamentoRepository) SubtreeHeight(id uuid.UUID, limit int) (int, error) {
+	var height int
+	sql := `
+	WITH RECURSIVE subdeps AS (
+		SELECT id, 1 AS depth
+		FROM departamentos
+		WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT d.id, s.depth + 1
+		FROM departamentos d
+		INNER JOIN subdeps s ON d.departamento_superior_id = s.id
+		WHERE s.depth < ? AND d.deleted_at IS NULL
+	)
+	SELECT COALESCE(MAX(depth), 0) FROM subdeps;
+	`
+	if err := r.db.Raw(sql, id, limit).Scan(&height).Error; err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// Ancestors retorna os departamentos da cadeia de id até a raiz, com o gerente
+// carregado, na mesma ordem de AncestorIDs (do próprio departamento para cima).
+func (r *DepartamentoRepository) Ancestors(id uuid.UUID, limit int) ([]models.Departamento, error) {
+	ids, err := r.AncestorIDs(id, limit)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var depts []models.Departamento
+	if err := r.db.Preload("Gerente").Where("id IN ?", ids).Find(&depts).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]models.Departamento, len(depts))
+	for _, d := range depts {
+		byID[d.ID] = d
+	}
+	ordered := make([]models.Departamento, 0, len(ids))
+	for _, id := range ids {
+		if d, ok := byID[id]; ok {
+			ordered = append(ordered, d)
+		}
+	}
+	return ordered, nil
+}
+
+// DescendantIDs retorna id e os ids de todos os departamentos abaixo dele,
+// limitado a limit níveis (ou maxHierarchyScan se limit <= 0).
+func (r *DepartamentoRepository) DescendantIDs(id uuid.UUID, limit int) ([]uuid.UUID, error) {
+	if limit <= 0 {
+		limit = maxHierarchyScan
+	}
+	var ids []uuid.UUID
+	sql := `
+	WITH RECURSIVE subdeps AS (
+		SELECT id, 1 AS depth
+		FROM departamentos
+		WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT d.id, s.depth + 1
+		FROM departamentos d
+		INNER JOIN subdeps s ON d.departamento_superior_id = s.id
+		WHERE s.depth < ? AND d.deleted_at IS NULL
+	)
+	SELECT DISTINCT id FROM subdeps;
+	`
+	if err := r.db.Raw(sql, id, limit).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeleteMany remove todos os departamentos informados.
+func (r *DepartamentoRepository) DeleteMany(ids []uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := r.db.Delete(&models.Departamento{}, "id IN ?", ids)
+	return res.RowsAffected, res.Error
+}
+
+// LogExclusao grava o registro de uma tentativa de exclusão.
+func (r *DepartamentoRepository) LogExclusao(e *models.DepartamentoExclusao) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return r.db.Create(e).Error
+}
+
+// FindChildren retorna os subdepartamentos diretos de id.
+func (r *DepartamentoRepository) FindChildren(id uuid.UUID) ([]models.Departamento, error) {
+	var depts []models.Departamento
+	if err := r.db.Where("departamento_superior_id = ?", id).Order("nome").Find(&depts).Error; err != nil {
+		return nil, err
+	}
+	return depts, nil
+}
+
+// FindByGerente retorna todos os departamentos chefiados pelo colaborador informado.
+func (r *DepartamentoRepository) FindByGerente(gerenteID uuid.UUID) ([]models.Departamento, error) {
+	var depts []models.Departamento
+	if err := r.db.Where("gerente_id = ?", gerenteID).Order("nome").Find(&depts).Error; err != nil {
+		return nil, err
+	}
+	return depts, nil
+}
+
+// Descendants retorna o departamento id e todos os departamentos abaixo dele,
+// ordenados por nível (o próprio departamento primeiro) e nome.
+func (r *DepartamentoRepository) Descendants(id uuid.UUID) ([]models.Departamento, error) {
+	var depts []models.Departamento
+	sql := `
+	WITH RECURSIVE subdeps AS (
+		SELECT id, 0 AS depth
+		FROM departamentos
+		WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT d.id, s.depth + 1
+		FROM departamentos d
+		INNER JOIN subdeps s ON d.departamento_superior_id = s.id
+		WHERE s.depth < ? AND d.deleted_at IS NULL
+	)
+	SELECT d.*
+	FROM departamentos d
+	INNER JOIN (SELECT id, MIN(depth) AS depth FROM subdeps GROUP BY id) s ON s.id = d.id
+	ORDER BY s.depth, d.nome;
+	`
+	if err := r.db.Raw(sql, id, maxHierarchyScan).Scan(&depts).Error; err != nil {
+		return nil, err
+	}
+	return depts, nil
+}
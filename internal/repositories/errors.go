@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrVersionConflict indica que o registro foi alterado (ou excluído) depois de
+// lido: a versão gravada não é mais a que acompanhava a atualização.
+var ErrVersionConflict = errors.New("registro alterado por outra operação")
+
+// uniqueViolation é o SQLSTATE do Postgres para violação de restrição única.
+const uniqueViolation = "23505"
+
+// colaboradorUniqueFields mapeia as restrições únicas de colaboradores para o
+// campo da API. Os nomes cobrem tanto as migrações do Flyway quanto o AutoMigrate.
+var colaboradorUniqueFields = map[string]string{
+	"colaboradores_cpf_key":   "cpf",
+	"idx_colaboradores_cpf":   "cpf",
+	"colaboradores_rg_key":    "rg",
+	"idx_colaboradores_rg":    "rg",
+	"idx_colaboradores_email": "email",
+}
+
+// UniqueViolation indica se err é uma violação de restrição única e, se for,
+// retorna o nome da restrição.
+func UniqueViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return pgErr.ConstraintName, true
+	}
+	return "", false
+}
+
+// ColaboradorDuplicateField retorna o campo ("cpf", "rg" ou "email") cuja
+// unicidade foi violada ao gravar um colaborador, ou "" se err não for isso.
+func ColaboradorDuplicateField(err error) string {
+	constraint, ok := UniqueViolation(err)
+	if !ok {
+		return ""
+	}
+	return colaboradorUniqueFields[constraint]
+}
@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LotacaoRepository acessa o histórico de lotações dos colaboradores.
+type LotacaoRepository struct {
+	db *gorm.DB
+}
+
+func NewLotacaoRepository(db *gorm.DB) *LotacaoRepository {
+	return &LotacaoRepository{db: db}
+}
+
+// WithTx retorna uma cópia do repositório que executa dentro da transação tx.
+func (r *LotacaoRepository) WithTx(tx *gorm.DB) *LotacaoRepository {
+	return &LotacaoRepository{db: tx}
+}
+
+func (r *LotacaoRepository) Create(l *models.ColaboradorLotacao) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return r.db.Create(l).Error
+}
+
+func (r *LotacaoRepository) Update(l *models.ColaboradorLotacao) error {
+	return r.db.Save(l).Error
+}
+
+// Current retorna a lotação do colaborador vigente na data on, ou nil se não houver.
+func (r *LotacaoRepository) Current(colaboradorID uuid.UUID, on time.Time) (*models.ColaboradorLotacao, error) {
+	var l models.ColaboradorLotacao
+	err := r.db.
+		Where("colaborador_id = ? AND valid_from <= ? AND (valid_to IS NULL OR valid_to > ?)", colaboradorID, on, on).
+		Order("valid_from DESC").
+		First(&l).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &l, nil
+}
+
+// DeleteAfter remove as lotações do colaborador que começam depois de after,
+// ou seja, as transferências ainda agendadas.
+func (r *LotacaoRepository) DeleteAfter(colaboradorID uuid.UUID, after time.Time) (int64, error) {
+	res := r.db.Delete(&models.ColaboradorLotacao{}, "colaborador_id = ? AND valid_from > ?", colaboradorID, after)
+	return res.RowsAffected, res.Error
+}
+
+// LotacaoDetalhe é uma lotação acompanhada do nome do departamento, mesmo que
+// o departamento tenha sido excluído depois.
+type LotacaoDetalhe struct {
+	models.ColaboradorLotacao
+	DepartamentoNome string
+}
+
+// ListByColaborador retorna o histórico completo do colaborador em ordem cronológica.
+func (r *LotacaoRepository) ListByColaborador(colaboradorID uuid.UUID) ([]LotacaoDetalhe, error) {
+	var list []LotacaoDetalhe
+	err := r.db.Model(&models.ColaboradorLotacao{}).
+		Select("colaborador_lotacoes.*, d.nome AS departamento_nome").
+		Joins("LEFT JOIN departamentos d ON d.id = colaborador_lotacoes.departamento_id").
+		Where("colaborador_lotacoes.colaborador_id = ?", colaboradorID).
+		Order("colaborador_lotacoes.valid_from, colaborador_lotacoes.created_at").
+		Scan(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// vigenteEm é o filtro das lotações vigentes em uma data, no intervalo [valid_from, valid_to).
+const vigenteEm = "valid_from <= ? AND (valid_to IS NULL OR valid_to > ?)"
+
+// CountByDepartamentoOn retorna quantos colaboradores cada departamento tinha na data on.
+func (r *LotacaoRepository) CountByDepartamentoOn(on time.Time) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		DepartamentoID uuid.UUID
+		Total          int64
+	}
+	if err := r.db.Model(&models.ColaboradorLotacao{}).
+		Select("departamento_id, COUNT(DISTINCT colaborador_id) AS total").
+		Where(vigenteEm, on, on).
+		Where("colaborador_id IN (SELECT id FROM colaboradores WHERE deleted_at IS NULL)").
+		Group("departamento_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DepartamentoID] = row.Total
+	}
+	return counts, nil
+}
+
+// DepartamentosOn retorna o departamento de cada colaborador informado na data on.
+func (r *LotacaoRepository) DepartamentosOn(colaboradorIDs []uuid.UUID, on time.Time) (map[uuid.UUID]uuid.UUID, error) {
+	result := make(map[uuid.UUID]uuid.UUID, len(colaboradorIDs))
+	if len(colaboradorIDs) == 0 {
+		return result, nil
+	}
+	var list []models.ColaboradorLotacao
+	if err := r.db.Where("colaborador_id IN ?", colaboradorIDs).
+		Where(vigenteEm, on, on).
+		Order("valid_from").
+		Find(&list).Error; err != nil {
+		return nil, err
+	}
+	for _, l := range list {
+		result[l.ColaboradorID] = l.DepartamentoID
+	}
+	return result, nil
+}
+
+// LotacaoVigente é a lotação de um colaborador em uma data, com o nome do colaborador.
+type LotacaoVigente struct {
+	ColaboradorID   uuid.UUID
+	ColaboradorNome string
+	DepartamentoID  uuid.UUID
+}
+
+// AllOn retorna a lotação vigente em on de todos os colaboradores não excluídos.
+func (r *LotacaoRepository) AllOn(on time.Time) ([]LotacaoVigente, error) {
+	var rows []LotacaoVigente
+	if err := r.db.Table("colaborador_lotacoes AS l").
+		Select("l.colaborador_id, c.nome AS colaborador_nome, l.departamento_id").
+		Joins("INNER JOIN colaboradores c ON c.id = l.colaborador_id AND c.deleted_at IS NULL").
+		Where("l.valid_from <= ? AND (l.valid_to IS NULL OR l.valid_to > ?)", on, on).
+		Order("c.nome").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ColaboradoresOn retorna os colaboradores lotados em algum dos departamentos na
+// data on, com DepartamentoID ajustado para o departamento daquela data.
+func (r *LotacaoRepository) ColaboradoresOn(departamentoIDs []uuid.UUID, on time.Time) ([]models.Colaborador, error) {
+	var list []models.Colaborador
+	if len(departamentoIDs) == 0 {
+		return list, nil
+	}
+	if err := r.db.
+		Where("id IN (SELECT colaborador_id FROM colaborador_lotacoes WHERE departamento_id IN ? AND "+vigenteEm+")", departamentoIDs, on, on).
+		Order("nome").
+		Find(&list).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, c := range list {
+		ids = append(ids, c.ID)
+	}
+	depts, err := r.DepartamentosOn(ids, on)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		list[i].DepartamentoID = depts[list[i].ID]
+	}
+	return list, nil
+}
+
+// ReassignDepartamento registra no histórico a mudança em massa dos colaboradores
+// de from para to a partir de on: lotações que começam em on ou depois (inclusive
+// as agendadas) passam a apontar para to; as que estavam vigentes são encerradas em
+// on e continuadas em to, preservando um eventual fim já agendado.
+func (r *LotacaoRepository) ReassignDepartamento(from, to uuid.UUID, on time.Time, motivo string) error {
+	if err := r.db.Model(&models.ColaboradorLotacao{}).
+		Where("departamento_id = ? AND valid_from >= ?", from, on).
+		Update("departamento_id", to).Error; err != nil {
+		return err
+	}
+
+	vigente := "departamento_id = ? AND valid_from < ? AND (valid_to IS NULL OR valid_to > ?)"
+	if err := r.db.Exec(`
+	INSERT INTO colaborador_lotacoes (id, colaborador_id, departamento_id, valid_from, valid_to, motivo, created_at)
+	SELECT uuid_generate_v4(), colaborador_id, ?, ?, valid_to, ?, now()
+	FROM colaborador_lotacoes
+	WHERE `+vigente, to, on, motivo, from, on, on).Error; err != nil {
+		return err
+	}
+	return r.db.Model(&models.ColaboradorLotacao{}).
+		Where(vigente, from, on, on).
+		Update("valid_to", on).Error
+}
+
+// Due retorna as lotações vigentes em on que apontam para um departamento diferente
+// do atual do colaborador, ou seja, as transferências agendadas que já podem ser
+// efetivadas. Departamentos excluídos nesse meio-tempo são ignorados.
+func (r *LotacaoRepository) Due(on time.Time) ([]models.ColaboradorLotacao, error) {
+	var list []models.ColaboradorLotacao
+	err := r.db.Raw(`
+	SELECT l.*
+	FROM colaborador_lotacoes l
+	INNER JOIN colaboradores c ON c.id = l.colaborador_id AND c.deleted_at IS NULL
+	INNER JOIN departamentos d ON d.id = l.departamento_id AND d.deleted_at IS NULL
+	WHERE l.valid_from <= ? AND (l.valid_to IS NULL OR l.valid_to > ?)
+	  AND c.departamento_id <> l.departamento_id
+	  AND c.status <> ?
+	ORDER BY l.valid_from
+	`, on, on, models.StatusDesligado).Scan(&list).Error
+	return list, err
+}
@@ -25,7 +25,7 @@ func NewGormDB(cfg DBConfig) (*gorm.DB, error) {
 	}
 
 	// AutoMigrate for development convenience. Remove in prod.
-	if err := db.AutoMigrate(&models.Colaborador{}, &models.Departamento{}); err != nil {
+	if err := db.AutoMigrate(&models.Colaborador{}, &models.Departamento{}, &models.DepartamentoExclusao{}, &models.Cargo{}, &models.DepartamentoCargo{}, &models.ColaboradorLotacao{}, &models.DepartamentoVersao{}, &models.AuditLog{}); err != nil {
 		log.Printf("warning: automigrate error: %v", err)
 	}
 
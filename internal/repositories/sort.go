@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// applySort aplica a ordenação pedida (ou a padrão) e desempata por id,
+// garantindo que a paginação seja estável entre requisições.
+func applySort(query *gorm.DB, sort []pagination.SortField, fallback pagination.SortField) *gorm.DB {
+	if len(sort) == 0 {
+		sort = []pagination.SortField{fallback}
+	}
+	hasID := false
+	for _, f := range sort {
+		if f.Column == "id" {
+			hasID = true
+		}
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: f.Column}, Desc: f.Desc})
+	}
+	if !hasID {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}})
+	}
+	return query
+}
+
+// applyKeyset filtra os registros posteriores ao cursor e ordena por (created_at, id).
+func applyKeyset(query *gorm.DB, p pagination.CursorParams) *gorm.DB {
+	if p.After != nil {
+		op := ">"
+		if p.Desc {
+			op = "<"
+		}
+		query = query.Where("(created_at, id) "+op+" (?, ?)", p.After.CreatedAt, p.After.ID)
+	}
+	return query.
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: p.Desc}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: p.Desc})
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// Repositories agrupa os repositórios que compartilham a mesma conexão ou transação.
+type Repositories struct {
+	Colaboradores *ColaboradorRepository
+	Departamentos *DepartamentoRepository
+	Cargos        *CargoRepository
+	Lotacoes      *LotacaoRepository
+	Auditoria     *AuditRepository
+}
+
+func newRepositories(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Colaboradores: NewColaboradorRepository(db),
+		Departamentos: NewDepartamentoRepository(db),
+		Cargos:        NewCargoRepository(db),
+		Lotacoes:      NewLotacaoRepository(db),
+		Auditoria:     NewAuditRepository(db),
+	}
+}
+
+// UnitOfWork executa operações de vários repositórios como uma unidade: tudo o
+// que for feito dentro de Do é confirmado junto ou desfeito junto.
+type UnitOfWork struct {
+	db    *gorm.DB
+	repos *Repositories
+}
+
+func NewUnitOfWork(db *gorm.DB) *UnitOfWork {
+	return &UnitOfWork{db: db, repos: newRepositories(db)}
+}
+
+// Repositories retorna os repositórios fora de transação, para leituras avulsas.
+func (u *UnitOfWork) Repositories() *Repositories {
+	return u.repos
+}
+
+// Do abre uma transação e executa fn com os repositórios vinculados a ela. A
+// transação é confirmada se fn retornar nil e desfeita caso contrário (ou em panic).
+func (u *UnitOfWork) Do(fn func(tx *Repositories) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(newRepositories(tx))
+	})
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danubiobwm/company-api/internal/audit"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// AuditService consulta a trilha de auditoria.
+type AuditService struct {
+	repo *repositories.AuditRepository
+}
+
+// NewAuditService cria uma nova instância de AuditService
+func NewAuditService(r *repositories.AuditRepository) *AuditService {
+	return &AuditService{repo: r}
+}
+
+// List retorna lista paginada das entradas de auditoria com filtros
+func (s *AuditService) List(filters map[string]interface{}, p pagination.Params) ([]models.AuditLog, int64, error) {
+	return s.repo.List(filters, p)
+}
+
+// record grava na trilha de auditoria a operação sobre a entidade. auditoria deve
+// estar vinculado à transação da própria alteração, para que as duas sejam
+// confirmadas ou desfeitas juntas.
+func record(ctx context.Context, auditoria *repositories.AuditRepository, entidade string, id uuid.UUID, operacao string, before, after interface{}) error {
+	entry, err := audit.NewEntry(ctx, entidade, id, operacao, before, after)
+	if err != nil || entry == nil {
+		return err
+	}
+	return auditoria.Create(entry)
+}
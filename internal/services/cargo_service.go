@@ -0,0 +1,238 @@
+package services
+
+import (
+	"strings"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// CargoService mantém o catálogo de cargos e as vagas de cada departamento.
+type CargoService struct {
+	repo     *repositories.CargoRepository
+	deptRepo *repositories.DepartamentoRepository
+}
+
+// NewCargoService cria uma nova instância de CargoService
+func NewCargoService(r *repositories.CargoRepository, dr *repositories.DepartamentoRepository) *CargoService {
+	return &CargoService{repo: r, deptRepo: dr}
+}
+
+// CargoVagas é um cargo com vagas em aberto no departamento. Ocupados conta os
+// colaboradores do departamento que já exercem o cargo.
+type CargoVagas struct {
+	Cargo    models.Cargo `json:"cargo"`
+	Vagas    int          `json:"vagas" example:"3"`
+	Ocupados int64        `json:"ocupados" example:"5"`
+}
+
+// List retorna lista paginada de cargos com filtros
+func (s *CargoService) List(filters map[string]interface{}, p pagination.Params) ([]models.Cargo, int64, error) {
+	return s.repo.List(filters, p)
+}
+
+// GetByID retorna cargo por UUID
+func (s *CargoService) GetByID(id uuid.UUID) (*models.Cargo, error) {
+	return s.repo.GetByID(id)
+}
+
+// Create cria um cargo no catálogo validando título, CBO e faixa salarial.
+func (s *CargoService) Create(c *models.Cargo) error {
+	if err := normalizeCargo(c); err != nil {
+		return err
+	}
+	if err := s.checkTitulo(c.Titulo, uuid.Nil); err != nil {
+		return err
+	}
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return s.repo.Create(c)
+}
+
+// Update atualiza um cargo existente.
+func (s *CargoService) Update(c *models.Cargo) error {
+	existing, err := s.repo.GetByID(c.ID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return dderr.NewWithCode(dderr.CodeCargoNotFound, "cargo não encontrado")
+	}
+	if err := normalizeCargo(c); err != nil {
+		return err
+	}
+	if err := s.checkTitulo(c.Titulo, c.ID); err != nil {
+		return err
+	}
+	c.CreatedAt = existing.CreatedAt
+	return s.repo.Update(c)
+}
+
+// Delete remove o cargo; cargos ainda ocupados por colaboradores não podem ser removidos.
+func (s *CargoService) Delete(id uuid.UUID) error {
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return dderr.NewWithCode(dderr.CodeCargoNotFound, "cargo não encontrado")
+	}
+	total, err := s.repo.CountColaboradores(id)
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		return dderr.NewWithCode(dderr.CodeCargoInUse, "cargo possui colaboradores").
+			WithDetails(map[string]interface{}{"colaboradores": total})
+	}
+	return s.repo.Delete(id)
+}
+
+// Vagas lista os cargos com vagas definidas no departamento.
+func (s *CargoService) Vagas(departamentoID uuid.UUID) ([]CargoVagas, error) {
+	if err := s.requireDepartamento(departamentoID); err != nil {
+		return nil, err
+	}
+	list, err := s.repo.ListVagas(departamentoID)
+	if err != nil {
+		return nil, err
+	}
+	ocupados, err := s.repo.CountOcupados(departamentoID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]CargoVagas, 0, len(list))
+	for _, dc := range list {
+		item := CargoVagas{Vagas: dc.Vagas, Ocupados: ocupados[dc.CargoID]}
+		if dc.Cargo != nil {
+			item.Cargo = *dc.Cargo
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// SetVagas define quantas vagas em aberto o departamento tem para o cargo.
+func (s *CargoService) SetVagas(departamentoID, cargoID uuid.UUID, vagas int) (*CargoVagas, error) {
+	if vagas < 0 {
+		return nil, dderr.NewWithCode(dderr.CodeCargoInvalid, "vagas não pode ser negativo").
+			WithKey("cargo.vagas_negativas", nil).
+			WithFieldParam("vagas", "min", "0", "vagas não pode ser negativo")
+	}
+	if err := s.requireDepartamento(departamentoID); err != nil {
+		return nil, err
+	}
+	cargo, err := s.repo.GetByID(cargoID)
+	if err != nil {
+		return nil, err
+	}
+	if cargo == nil {
+		return nil, dderr.NewWithCode(dderr.CodeCargoNotFound, "cargo não encontrado")
+	}
+
+	dc := &models.DepartamentoCargo{DepartamentoID: departamentoID, CargoID: cargoID, Vagas: vagas}
+	if err := s.repo.SetVagas(dc); err != nil {
+		return nil, err
+	}
+	ocupados, err := s.repo.CountOcupados(departamentoID)
+	if err != nil {
+		return nil, err
+	}
+	return &CargoVagas{Cargo: *cargo, Vagas: vagas, Ocupados: ocupados[cargoID]}, nil
+}
+
+// RemoveVagas retira o cargo da lista de vagas do departamento.
+func (s *CargoService) RemoveVagas(departamentoID, cargoID uuid.UUID) error {
+	removed, err := s.repo.DeleteVagas(departamentoID, cargoID)
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return dderr.NewWithCode(dderr.CodeCargoNotFound, "cargo sem vagas no departamento").
+			WithKey("cargo.sem_vagas", nil)
+	}
+	return nil
+}
+
+func (s *CargoService) requireDepartamento(id uuid.UUID) error {
+	dept, err := s.deptRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if dept == nil {
+		return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+	}
+	return nil
+}
+
+func (s *CargoService) checkTitulo(titulo string, id uuid.UUID) error {
+	other, err := s.repo.GetByTitulo(titulo)
+	if err != nil {
+		return err
+	}
+	if other != nil && other.ID != id {
+		return dderr.NewWithCode(dderr.CodeCargoDuplicate, "cargo já cadastrado")
+	}
+	return nil
+}
+
+// normalizeCargo valida o cargo e normaliza os campos opcionais: strings vazias
+// viram nil e o CBO fica só com os 6 dígitos (aceita "2124-05").
+func normalizeCargo(c *models.Cargo) error {
+	c.Titulo = strings.TrimSpace(c.Titulo)
+	if c.Titulo == "" {
+		return dderr.NewWithCode(dderr.CodeCargoInvalid, "titulo é obrigatório").
+			WithKey("cargo.titulo_obrigatorio", nil).
+			WithField("titulo", "required", "titulo é obrigatório")
+	}
+	if c.Nivel != nil {
+		if nivel := strings.TrimSpace(*c.Nivel); nivel == "" {
+			c.Nivel = nil
+		} else {
+			c.Nivel = &nivel
+		}
+	}
+	if c.CBO != nil {
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			if r == '-' || r == '.' || r == ' ' {
+				return -1
+			}
+			return 'x'
+		}, *c.CBO)
+		switch {
+		case digits == "":
+			c.CBO = nil
+		case len(digits) != 6 || strings.Contains(digits, "x"):
+			return dderr.NewWithCode(dderr.CodeCargoInvalid, "cbo inválido").
+				WithKey("cargo.cbo_invalido", nil).
+				WithField("cbo", "cbo", "cbo inválido")
+		default:
+			c.CBO = &digits
+		}
+	}
+	negative := dderr.NewWithCode(dderr.CodeCargoInvalid, "faixa salarial não pode ser negativa").
+		WithKey("cargo.faixa_negativa", nil)
+	if c.SalarioMin != nil && *c.SalarioMin < 0 {
+		negative.WithFieldParam("salario_min", "min", "0", "salario_min não pode ser negativo")
+	}
+	if c.SalarioMax != nil && *c.SalarioMax < 0 {
+		negative.WithFieldParam("salario_max", "min", "0", "salario_max não pode ser negativo")
+	}
+	if len(negative.Fields) > 0 {
+		return negative
+	}
+	if c.SalarioMin != nil && c.SalarioMax != nil && *c.SalarioMin > *c.SalarioMax {
+		return dderr.NewWithCode(dderr.CodeCargoInvalid, "salario_min maior que salario_max").
+			WithKey("cargo.faixa_invertida", nil).
+			WithFieldParam("salario_min", "ltefield", "salario_max", "salario_min maior que salario_max")
+	}
+	return nil
+}
@@ -1,68 +1,152 @@
 package services
 
 import (
+	"context"
 	"strings"
+	"time"
 
+	"github.com/danubiobwm/company-api/internal/cpf"
 	dderr "github.com/danubiobwm/company-api/internal/errors"
 	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
 	"github.com/danubiobwm/company-api/internal/repositories"
 	"github.com/google/uuid"
 )
 
 type ColaboradorService struct {
-	repo     *repositories.ColaboradorRepository
-	deptRepo *repositories.DepartamentoRepository
+	uow         *repositories.UnitOfWork
+	repo        *repositories.ColaboradorRepository
+	deptRepo    *repositories.DepartamentoRepository
+	lotacaoRepo *repositories.LotacaoRepository
 }
 
-func NewColaboradorService(r *repositories.ColaboradorRepository, dr *repositories.DepartamentoRepository) *ColaboradorService {
-	return &ColaboradorService{repo: r, deptRepo: dr}
+// NewColaboradorService cria o serviço sobre a unidade de trabalho: operações com
+// mais de um passo rodam em uma transação; leituras avulsas usam os repositórios dela.
+func NewColaboradorService(uow *repositories.UnitOfWork) *ColaboradorService {
+	repos := uow.Repositories()
+	return &ColaboradorService{
+		uow:         uow,
+		repo:        repos.Colaboradores,
+		deptRepo:    repos.Departamentos,
+		lotacaoRepo: repos.Lotacoes,
+	}
 }
 
-// Create cria um novo colaborador com validações (CPF/RG/Depto).
-func (s *ColaboradorService) Create(c *models.Colaborador) error {
+// Create cria um novo colaborador com validações (CPF/RG/Depto). As verificações
+// e a gravação ocorrem na mesma transação; a restrição única do banco cobre
+// cadastros concorrentes com o mesmo CPF, RG ou e-mail.
+func (s *ColaboradorService) Create(ctx context.Context, c *models.Colaborador) error {
 	if strings.TrimSpace(c.Nome) == "" {
-		return dderr.New("nome é obrigatório")
+		return dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "nome é obrigatório").
+			WithKey("colaborador.nome_obrigatorio", nil).
+			WithField("nome", "required", "nome é obrigatório")
 	}
 
-	if !validateCPF(c.CPF) {
-		return dderr.New("cpf inválido")
+	if !cpf.Valid(c.CPF) {
+		return dderr.NewWithCode(dderr.CodeColaboradorCPFInvalid, "cpf inválido").WithField("cpf", "cpf", "cpf inválido")
 	}
+	c.CPF = cpf.Normalize(c.CPF)
 
-	// CPF único
-	existing, err := s.repo.GetByCPF(c.CPF)
-	if err != nil {
+	// Contato: formato
+	if err := normalizeContato(c); err != nil {
 		return err
 	}
-	if existing != nil {
-		return dderr.New("cpf já cadastrado")
-	}
 
-	// RG único (se informado)
-	if c.RG != nil && *c.RG != "" {
-		existingRG, err := s.repo.GetByRG(*c.RG)
-		if err != nil {
-			return err
-		}
-		if existingRG != nil {
-			return dderr.New("rg já cadastrado")
-		}
+	// Vínculo: todo colaborador nasce ativo ou afastado; desligamento só pelo endpoint próprio
+	if c.Status == "" {
+		c.Status = models.StatusAtivo
 	}
-
-	// Departamento existe
-	dept, err := s.deptRepo.GetByID(c.DepartamentoID)
-	if err != nil {
-		return err
+	if c.Status != models.StatusAtivo && c.Status != models.StatusAfastado {
+		return dderr.NewWithCode(dderr.CodeColaboradorStatusInvalid, "status inválido").
+			WithFieldParam("status", "oneof", "ativo afastado", "status inválido")
 	}
-	if dept == nil {
-		return dderr.New("departamento não existe")
+	if c.DataAdmissao == nil {
+		hoje := today()
+		c.DataAdmissao = &hoje
 	}
+	c.DataDesligamento = nil
+	c.MotivoDesligamento = nil
 
 	// gerar ID se ausente
 	if c.ID == uuid.Nil {
 		c.ID = uuid.New()
 	}
+	c.Version = 1
+
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		// CPF único
+		existing, err := tx.Colaboradores.GetByCPF(c.CPF)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return dderr.NewWithCode(dderr.CodeColaboradorCPFDuplicate, "cpf já cadastrado")
+		}
+
+		// RG único (se informado)
+		if c.RG != nil && *c.RG != "" {
+			existingRG, err := tx.Colaboradores.GetByRG(*c.RG)
+			if err != nil {
+				return err
+			}
+			if existingRG != nil {
+				return dderr.NewWithCode(dderr.CodeColaboradorRGDuplicate, "rg já cadastrado")
+			}
+		}
+
+		// E-mail corporativo único
+		if c.Email != nil {
+			existingEmail, err := tx.Colaboradores.GetByEmail(*c.Email)
+			if err != nil {
+				return err
+			}
+			if existingEmail != nil {
+				return dderr.NewWithCode(dderr.CodeColaboradorEmailDuplicate, "email já cadastrado")
+			}
+		}
 
-	return s.repo.Create(c)
+		// Departamento existe
+		dept, err := tx.Departamentos.GetByID(c.DepartamentoID)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return dderr.NewWithCode(dderr.CodeColaboradorDepartamentoNotFound, "departamento não existe")
+		}
+
+		// Cargo existe (se informado)
+		if err := validateCargo(tx.Cargos, c.CargoID); err != nil {
+			return err
+		}
+
+		if err := tx.Colaboradores.Create(c); err != nil {
+			return colaboradorWriteError(err)
+		}
+		// o cadastro abre a primeira lotação do histórico, a partir da admissão
+		if err := tx.Lotacoes.Create(&models.ColaboradorLotacao{
+			ColaboradorID:  c.ID,
+			DepartamentoID: c.DepartamentoID,
+			ValidFrom:      *c.DataAdmissao,
+		}); err != nil {
+			return err
+		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, c.ID, models.AuditCreate, nil, c)
+	})
+}
+
+// colaboradorWriteError traduz a violação de unicidade detectada pelo banco no
+// mesmo erro de domínio das verificações feitas antes da gravação, e a gravação
+// recusada por versão desatualizada em conflito de versão.
+func colaboradorWriteError(err error) error {
+	switch repositories.ColaboradorDuplicateField(err) {
+	case "cpf":
+		return dderr.NewWithCode(dderr.CodeColaboradorCPFDuplicate, "cpf já cadastrado")
+	case "rg":
+		return dderr.NewWithCode(dderr.CodeColaboradorRGDuplicate, "rg já cadastrado")
+	case "email":
+		return dderr.NewWithCode(dderr.CodeColaboradorEmailDuplicate, "email já cadastrado")
+	}
+	return versionError(err)
 }
 
 // GetByID retorna colaborador por UUID
@@ -70,103 +154,408 @@ func (s *ColaboradorService) GetByID(id uuid.UUID) (*models.Colaborador, error)
 	return s.repo.GetByID(id)
 }
 
-// Update atualiza colaborador (validações básicas)
-func (s *ColaboradorService) Update(c *models.Colaborador) error {
-	// checar existência
-	existing, err := s.repo.GetByID(c.ID)
+// GetByIDUnscoped retorna colaborador por UUID, incluindo os excluídos logicamente.
+func (s *ColaboradorService) GetByIDUnscoped(id uuid.UUID) (*models.Colaborador, error) {
+	return s.repo.GetByIDUnscoped(id)
+}
+
+// Restore desfaz a exclusão lógica do colaborador, desde que seu departamento
+// ainda esteja ativo. Colaboradores não desligados ganham uma nova lotação a
+// partir de hoje; a gerência perdida na exclusão precisa ser reatribuída.
+func (s *ColaboradorService) Restore(ctx context.Context, id uuid.UUID) (*models.Colaborador, error) {
+	existing, err := s.repo.GetByIDUnscoped(id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if existing == nil {
-		return dderr.New("colaborador não encontrado")
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+	}
+	if !existing.DeletedAt.Valid {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotDeleted, "colaborador não está excluído")
+	}
+	dept, err := s.deptRepo.GetByID(existing.DepartamentoID)
+	if err != nil {
+		return nil, err
 	}
+	if dept == nil {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorRestoreBlocked, "departamento do colaborador está excluído; restaure-o primeiro")
+	}
+	err = s.uow.Do(func(tx *repositories.Repositories) error {
+		if err := tx.Colaboradores.Restore(id); err != nil {
+			return colaboradorWriteError(err)
+		}
+		// a exclusão encerrou a lotação; quem não está desligado volta a ser lotado
+		// a partir de hoje. A chefia desfeita na exclusão não é reatribuída.
+		if existing.Status != models.StatusDesligado {
+			if err := tx.Lotacoes.Create(&models.ColaboradorLotacao{
+				ColaboradorID:  id,
+				DepartamentoID: existing.DepartamentoID,
+				ValidFrom:      today(),
+			}); err != nil {
+				return err
+			}
+		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditRestore, nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(id)
+}
+
+// Update atualiza colaborador (validações básicas). Verificações e gravação
+// ocorrem na mesma transação. c.Version, quando diferente de zero, é a versão
+// que o cliente leu: se o registro mudou desde então, a atualização é recusada.
+func (s *ColaboradorService) Update(ctx context.Context, c *models.Colaborador) error {
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		// checar existência
+		existing, err := tx.Colaboradores.GetByID(c.ID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+		}
+		if err := checkVersion(c.Version, existing.Version); err != nil {
+			return err
+		}
+		return s.update(ctx, tx, existing, c)
+	})
+}
+
+// colaboradorPatchFields são os campos aceitos em PATCH. Desligamento e
+// readmissão continuam restritos aos endpoints próprios.
+var colaboradorPatchFields = map[string]patchField{
+	"nome":            {},
+	"cpf":             {},
+	"rg":              {nullable: true},
+	"email":           {nullable: true},
+	"email_pessoal":   {nullable: true},
+	"telefone":        {nullable: true},
+	"celular":         {nullable: true},
+	"departamento_id": {},
+	"cargo_id":        {nullable: true},
+	"status":          {},
+	"data_admissao":   {nullable: true},
+}
 
-	// se CPF mudou, validar unicidade e formato
+// Patch aplica um JSON Merge Patch (RFC 7396) ao colaborador e grava o resultado
+// com as mesmas validações de Update. validate recebe o colaborador resultante
+// antes da gravação, para aplicar as regras de formato dos campos que a camada
+// HTTP aplica ao PUT. version, quando diferente de zero, precisa ser a versão
+// atual do registro.
+func (s *ColaboradorService) Patch(ctx context.Context, id uuid.UUID, version int, patch []byte, validate func(*models.Colaborador) error) (*models.Colaborador, error) {
+	var c models.Colaborador
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		existing, err := tx.Colaboradores.GetByIDForUpdate(id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+		}
+		if err := checkVersion(version, existing.Version); err != nil {
+			return err
+		}
+		c = *existing
+		if err := applyMergePatch(&c, patch, colaboradorPatchFields); err != nil {
+			return err
+		}
+		if err := validate(&c); err != nil {
+			return err
+		}
+		return s.update(ctx, tx, existing, &c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// update valida e grava c sobre existing, o registro atual, dentro de tx.
+func (s *ColaboradorService) update(ctx context.Context, tx *repositories.Repositories, existing, c *models.Colaborador) error {
+	c.ID = existing.ID
+	c.Version = existing.Version
+
+	// se CPF mudou, validar unicidade e formato; a comparação ignora a pontuação
+	c.CPF = cpf.Normalize(c.CPF)
 	if c.CPF != existing.CPF {
-		if !validateCPF(c.CPF) {
-			return dderr.New("cpf inválido")
+		if !cpf.Valid(c.CPF) {
+			return dderr.NewWithCode(dderr.CodeColaboradorCPFInvalid, "cpf inválido").WithField("cpf", "cpf", "cpf inválido")
 		}
-		if other, err := s.repo.GetByCPF(c.CPF); err != nil {
+		if other, err := tx.Colaboradores.GetByCPF(c.CPF); err != nil {
 			return err
 		} else if other != nil && other.ID != existing.ID {
-			return dderr.New("cpf já cadastrado")
+			return dderr.NewWithCode(dderr.CodeColaboradorCPFDuplicate, "cpf já cadastrado")
 		}
 	}
 
 	// se RG mudou, validar unicidade
 	if c.RG != nil && (existing.RG == nil || *c.RG != *existing.RG) {
-		if other, err := s.repo.GetByRG(*c.RG); err != nil {
+		if other, err := tx.Colaboradores.GetByRG(*c.RG); err != nil {
+			return err
+		} else if other != nil && other.ID != existing.ID {
+			return dderr.NewWithCode(dderr.CodeColaboradorRGDuplicate, "rg já cadastrado")
+		}
+	}
+
+	// contato: formato e, se o e-mail corporativo mudou, unicidade
+	if err := normalizeContato(c); err != nil {
+		return err
+	}
+	if c.Email != nil && (existing.Email == nil || *c.Email != *existing.Email) {
+		if other, err := tx.Colaboradores.GetByEmail(*c.Email); err != nil {
 			return err
 		} else if other != nil && other.ID != existing.ID {
-			return dderr.New("rg já cadastrado")
+			return dderr.NewWithCode(dderr.CodeColaboradorEmailDuplicate, "email já cadastrado")
 		}
 	}
 
 	// departamento existe
-	if _, err := s.deptRepo.GetByID(c.DepartamentoID); err != nil {
+	dept, err := tx.Departamentos.GetByID(c.DepartamentoID)
+	if err != nil {
+		return err
+	}
+	if dept == nil {
+		return dderr.NewWithCode(dderr.CodeColaboradorDepartamentoNotFound, "departamento não existe")
+	}
+
+	// cargo existe (se informado)
+	if err := validateCargo(tx.Cargos, c.CargoID); err != nil {
+		return err
+	}
+
+	// vínculo: o PUT só alterna entre ativo e afastado; desligar e readmitir têm endpoints próprios
+	if err := applyStatusUpdate(c, existing); err != nil {
 		return err
 	}
 
-	return s.repo.Update(c)
+	// mudança de departamento vale a partir de hoje e fica no histórico de lotações
+	transfer := c.DepartamentoID != existing.DepartamentoID
+	if transfer && existing.Status == models.StatusDesligado {
+		return dderr.NewWithCode(dderr.CodeColaboradorTerminated, "colaborador desligado não pode ser transferido").
+			WithKey("colaborador.desligado_transferencia", nil)
+	}
+	if err := tx.Colaboradores.Update(c); err != nil {
+		return colaboradorWriteError(err)
+	}
+	if transfer {
+		hoje := today()
+		if _, err := applyTransfer(tx.Lotacoes, c.ID, c.DepartamentoID, hoje, hoje, nil); err != nil {
+			return err
+		}
+	}
+	return record(ctx, tx.Auditoria, models.AuditColaborador, c.ID, models.AuditUpdate, existing, c)
+}
+
+// applyStatusUpdate preserva os dados de desligamento já gravados e valida a
+// troca de status feita por atualização comum.
+func applyStatusUpdate(c, existing *models.Colaborador) error {
+	if c.Status == "" {
+		c.Status = existing.Status
+	}
+	if c.DataAdmissao == nil {
+		c.DataAdmissao = existing.DataAdmissao
+	}
+	c.DataDesligamento = existing.DataDesligamento
+	c.MotivoDesligamento = existing.MotivoDesligamento
+	c.CreatedAt = existing.CreatedAt
+
+	if c.Status == existing.Status {
+		return nil
+	}
+	if existing.Status == models.StatusDesligado {
+		return dderr.NewWithCode(dderr.CodeColaboradorTerminated, "colaborador desligado; use a readmissão").
+			WithKey("colaborador.desligado_use_readmissao", nil)
+	}
+	if c.Status != models.StatusAtivo && c.Status != models.StatusAfastado {
+		return dderr.NewWithCode(dderr.CodeColaboradorStatusInvalid, "status inválido; use o desligamento").
+			WithKey("colaborador.status_use_desligamento", nil).
+			WithFieldParam("status", "oneof", "ativo afastado", "status inválido; use o desligamento")
+	}
+	return nil
 }
 
-// Delete remove colaborador por id
-func (s *ColaboradorService) Delete(id uuid.UUID) error {
-	// opcional: checar existência
+// Terminate desliga o colaborador na data informada (hoje, se nil). O registro
+// continua na base e pode ser consultado, mas sai das listagens padrão.
+func (s *ColaboradorService) Terminate(ctx context.Context, id uuid.UUID, data *time.Time, motivo string) (*models.Colaborador, error) {
 	existing, err := s.repo.GetByID(id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if existing == nil {
-		return dderr.New("colaborador não encontrado")
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+	}
+	if existing.Status == models.StatusDesligado {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorTerminated, "colaborador já desligado").
+			WithKey("colaborador.ja_desligado", nil)
+	}
+	motivo = strings.TrimSpace(motivo)
+	if motivo == "" {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "motivo do desligamento é obrigatório").
+			WithKey("colaborador.motivo_obrigatorio", nil).
+			WithField("motivo", "required", "motivo do desligamento é obrigatório")
+	}
+	if data == nil {
+		hoje := today()
+		data = &hoje
+	}
+	if existing.DataAdmissao != nil && data.Before(*existing.DataAdmissao) {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "data de desligamento anterior à admissão").
+			WithKey("colaborador.desligamento_antes_admissao", nil).
+			WithFieldParam("data_desligamento", "gtefield", "data_admissao", "data de desligamento anterior à admissão")
 	}
-	return s.repo.Delete(id)
-}
 
-// List retorna lista paginada de colaboradores com filtros
-func (s *ColaboradorService) List(filters map[string]interface{}, page, limit int) ([]models.Colaborador, int64, error) {
-	return s.repo.List(filters, page, limit)
+	before := *existing
+	existing.Status = models.StatusDesligado
+	existing.DataDesligamento = data
+	existing.MotivoDesligamento = &motivo
+	err = s.uow.Do(func(tx *repositories.Repositories) error {
+		if err := tx.Colaboradores.Update(existing); err != nil {
+			return versionError(err)
+		}
+		if err := closeLotacao(tx.Lotacoes, id, *data); err != nil {
+			return err
+		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditUpdate, &before, existing)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
 }
 
-// validateCPF - mesma implementação que você já usou (limpa e calcula dígitos)
-func validateCPF(c string) bool {
-	s := ""
-	for _, r := range c {
-		if r >= '0' && r <= '9' {
-			s += string(r)
-		}
+// Rehire readmite um colaborador desligado com nova data de admissão (hoje, se nil)
+// e limpa os dados do desligamento anterior.
+func (s *ColaboradorService) Rehire(ctx context.Context, id uuid.UUID, admissao *time.Time) (*models.Colaborador, error) {
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
 	}
-	if len(s) != 11 {
-		return false
+	if existing.Status != models.StatusDesligado {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotTerminated, "colaborador não está desligado")
 	}
-	seq := true
-	for i := 1; i < 11; i++ {
-		if s[i] != s[0] {
-			seq = false
-			break
+	if admissao == nil {
+		hoje := today()
+		admissao = &hoje
+	}
+	if existing.DataDesligamento != nil && !admissao.After(*existing.DataDesligamento) {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorDataInvalid, "data de readmissão deve ser posterior ao desligamento").
+			WithKey("colaborador.readmissao_antes_desligamento", nil).
+			WithFieldParam("data_admissao", "gtfield", "data_desligamento", "data de readmissão deve ser posterior ao desligamento")
+	}
+
+	before := *existing
+	existing.Status = models.StatusAtivo
+	existing.DataAdmissao = admissao
+	existing.DataDesligamento = nil
+	existing.MotivoDesligamento = nil
+	err = s.uow.Do(func(tx *repositories.Repositories) error {
+		if err := tx.Colaboradores.Update(existing); err != nil {
+			return versionError(err)
+		}
+		if err := tx.Lotacoes.Create(&models.ColaboradorLotacao{
+			ColaboradorID:  id,
+			DepartamentoID: existing.DepartamentoID,
+			ValidFrom:      *admissao,
+		}); err != nil {
+			return err
 		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditUpdate, &before, existing)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// today retorna a data corrente (UTC) sem o horário.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// validateCargo confere se o cargo informado existe no catálogo.
+func validateCargo(cargos *repositories.CargoRepository, id *uuid.UUID) error {
+	if id == nil {
+		return nil
 	}
-	if seq {
-		return false
+	cargo, err := cargos.GetByID(*id)
+	if err != nil {
+		return err
 	}
+	if cargo == nil {
+		return dderr.NewWithCode(dderr.CodeColaboradorCargoNotFound, "cargo não existe")
+	}
+	return nil
+}
 
-	calc := func(digs []int) int {
-		sum := 0
-		for i, v := range digs {
-			sum += v * (len(digs) + 1 - i)
+// Delete exclui logicamente o colaborador; ele pode ser restaurado até o expurgo.
+// version, quando diferente de zero, precisa ser a versão atual do registro.
+func (s *ColaboradorService) Delete(ctx context.Context, id uuid.UUID, version int) error {
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		existing, err := tx.Colaboradores.GetByIDForUpdate(id)
+		if err != nil {
+			return err
 		}
-		mod := sum % 11
-		if mod < 2 {
-			return 0
+		if existing == nil {
+			return dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
 		}
-		return 11 - mod
+		if err := checkVersion(version, existing.Version); err != nil {
+			return err
+		}
+		if err := detachColaborador(ctx, tx, id); err != nil {
+			return err
+		}
+		if err := tx.Colaboradores.Delete(id); err != nil {
+			return err
+		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditDelete, existing, nil)
+	})
+}
+
+// detachColaborador desfaz os vínculos de um colaborador que está sendo excluído
+// logicamente, já que a exclusão lógica não dispara as chaves estrangeiras: os
+// departamentos que ele chefia ficam sem gerente e a lotação vigente termina no
+// dia da exclusão, descartando transferências agendadas.
+func detachColaborador(ctx context.Context, tx *repositories.Repositories, id uuid.UUID) error {
+	chefiados, err := tx.Departamentos.FindByGerente(id)
+	if err != nil {
+		return err
 	}
+	for i := range chefiados {
+		before := chefiados[i]
+		chefiados[i].GerenteID = nil
+		if err := tx.Departamentos.Update(&chefiados[i]); err != nil {
+			return versionError(err)
+		}
+		if err := record(ctx, tx.Auditoria, models.AuditDepartamento, chefiados[i].ID, models.AuditUpdate, &before, &chefiados[i]); err != nil {
+			return err
+		}
+	}
+	// o dia da exclusão já não conta como lotado
+	return closeLotacao(tx.Lotacoes, id, today().AddDate(0, 0, -1))
+}
+
+// List retorna lista paginada de colaboradores com filtros
+func (s *ColaboradorService) List(filters map[string]interface{}, p pagination.Params) ([]models.Colaborador, int64, error) {
+	return s.repo.List(filters, p)
+}
 
-	digs := make([]int, 11)
-	for i := 0; i < 11; i++ {
-		digs[i] = int(s[i] - '0')
+// ListByCursor retorna uma página de colaboradores no modo cursor e, se pedido, o total.
+func (s *ColaboradorService) ListByCursor(filters map[string]interface{}, p pagination.CursorParams) ([]models.Colaborador, *pagination.Cursor, *int64, error) {
+	list, next, err := s.repo.ListByCursor(filters, p)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !p.WithTotal {
+		return list, next, nil, nil
+	}
+	total, err := s.repo.Count(filters)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	d1 := calc(digs[:9])
-	d2 := calc(append(digs[:9], d1))
-	return d1 == digs[9] && d2 == digs[10]
+	return list, next, &total, nil
 }
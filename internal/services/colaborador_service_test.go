@@ -0,0 +1,90 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyStatusUpdate(t *testing.T) {
+	admissao := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	desligamento := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	motivo := "fim de contrato"
+
+	ativo := &models.Colaborador{Status: models.StatusAtivo, DataAdmissao: &admissao}
+	c := &models.Colaborador{}
+	require.NoError(t, applyStatusUpdate(c, ativo))
+	assert.Equal(t, models.StatusAtivo, c.Status)
+	assert.Equal(t, &admissao, c.DataAdmissao)
+
+	c = &models.Colaborador{Status: models.StatusAfastado}
+	assert.NoError(t, applyStatusUpdate(c, ativo))
+
+	var domainErr *dderr.DomainError
+	c = &models.Colaborador{Status: models.StatusDesligado}
+	require.ErrorAs(t, applyStatusUpdate(c, ativo), &domainErr)
+	assert.Equal(t, dderr.CodeColaboradorStatusInvalid, domainErr.Code)
+
+	desligado := &models.Colaborador{
+		Status:             models.StatusDesligado,
+		DataAdmissao:       &admissao,
+		DataDesligamento:   &desligamento,
+		MotivoDesligamento: &motivo,
+	}
+	c = &models.Colaborador{}
+	require.NoError(t, applyStatusUpdate(c, desligado))
+	assert.Equal(t, &desligamento, c.DataDesligamento)
+	assert.Equal(t, &motivo, c.MotivoDesligamento)
+
+	c = &models.Colaborador{Status: models.StatusAtivo}
+	require.ErrorAs(t, applyStatusUpdate(c, desligado), &domainErr)
+	assert.Equal(t, dderr.CodeColaboradorTerminated, domainErr.Code)
+}
+
+func TestLotacoesOnUsesHalfOpenIntervals(t *testing.T) {
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	ti, rh := uuid.New(), uuid.New()
+	fim := day(3, 1)
+	list := []repositories.LotacaoDetalhe{
+		{ColaboradorLotacao: models.ColaboradorLotacao{DepartamentoID: ti, ValidFrom: day(1, 1), ValidTo: &fim}},
+		{ColaboradorLotacao: models.ColaboradorLotacao{DepartamentoID: rh, ValidFrom: fim}},
+	}
+
+	assert.Empty(t, lotacoesOn(list, day(12, 31).AddDate(-1, 0, 0)))
+	require.Len(t, lotacoesOn(list, day(2, 28)), 1)
+	assert.Equal(t, ti, lotacoesOn(list, day(2, 28))[0].DepartamentoID)
+	require.Len(t, lotacoesOn(list, fim), 1)
+	assert.Equal(t, rh, lotacoesOn(list, fim)[0].DepartamentoID)
+	assert.Equal(t, rh, lotacoesOn(list, day(12, 31))[0].DepartamentoID)
+}
+
+func TestColaboradorWriteErrorMapsUniqueViolations(t *testing.T) {
+	cases := []struct {
+		constraint string
+		want       string
+	}{
+		{"colaboradores_cpf_key", "[COLABORADOR_CPF_DUPLICATE] cpf já cadastrado"},
+		{"idx_colaboradores_rg", "[COLABORADOR_RG_DUPLICATE] rg já cadastrado"},
+		{"idx_colaboradores_email", "[COLABORADOR_EMAIL_DUPLICATE] email já cadastrado"},
+	}
+	for _, tc := range cases {
+		raw := fmt.Errorf("insert: %w", &pgconn.PgError{Code: "23505", ConstraintName: tc.constraint})
+		err := colaboradorWriteError(raw)
+
+		var domainErr *dderr.DomainError
+		require.True(t, errors.As(err, &domainErr), tc.constraint)
+		assert.Equal(t, tc.want, domainErr.Error())
+	}
+
+	other := errors.New("conexão perdida")
+	assert.Same(t, other, colaboradorWriteError(other))
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"errors"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/repositories"
+)
+
+// versionConflict é o erro de uma gravação feita sobre uma versão desatualizada.
+func versionConflict() error {
+	return dderr.NewWithCode(dderr.CodeVersionConflict, "registro alterado por outra requisição; recarregue e tente novamente")
+}
+
+// checkVersion confere a versão esperada pelo cliente com a gravada.
+// expected 0 indica que o cliente não enviou pré-condição.
+func checkVersion(expected, current int) error {
+	if expected != 0 && expected != current {
+		return versionConflict()
+	}
+	return nil
+}
+
+// versionError traduz a gravação recusada pelo repositório por versão desatualizada.
+func versionError(err error) error {
+	if errors.Is(err, repositories.ErrVersionConflict) {
+		return versionConflict()
+	}
+	return err
+}
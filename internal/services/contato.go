@@ -0,0 +1,74 @@
+package services
+
+import (
+	"net/mail"
+	"strings"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+)
+
+// normalizeContato valida e normaliza os e-mails e telefones do colaborador.
+// Strings vazias viram nil, e-mails ficam em minúsculas e telefones só com dígitos
+// (preservando o "+" do código de país).
+func normalizeContato(c *models.Colaborador) error {
+	var ok bool
+	if c.Email, ok = normalizeEmail(c.Email); !ok {
+		return dderr.NewWithCode(dderr.CodeColaboradorEmailInvalid, "email inválido").
+			WithKey("colaborador.email_invalido", nil).
+			WithField("email", "email", "email inválido")
+	}
+	if c.EmailPessoal, ok = normalizeEmail(c.EmailPessoal); !ok {
+		return dderr.NewWithCode(dderr.CodeColaboradorEmailInvalid, "email_pessoal inválido").
+			WithKey("colaborador.email_pessoal_invalido", nil).
+			WithField("email_pessoal", "email", "email_pessoal inválido")
+	}
+	if c.Telefone, ok = normalizeTelefone(c.Telefone); !ok {
+		return dderr.NewWithCode(dderr.CodeColaboradorTelefoneInvalid, "telefone inválido").
+			WithKey("colaborador.telefone_invalido", nil).
+			WithField("telefone", "phone", "telefone inválido")
+	}
+	if c.Celular, ok = normalizeTelefone(c.Celular); !ok {
+		return dderr.NewWithCode(dderr.CodeColaboradorTelefoneInvalid, "celular inválido").
+			WithKey("colaborador.celular_invalido", nil).
+			WithField("celular", "phone", "celular inválido")
+	}
+	return nil
+}
+
+func normalizeEmail(v *string) (*string, bool) {
+	if v == nil || strings.TrimSpace(*v) == "" {
+		return nil, true
+	}
+	email := strings.ToLower(strings.TrimSpace(*v))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || len(email) > 255 {
+		return nil, false
+	}
+	return &email, true
+}
+
+func normalizeTelefone(v *string) (*string, bool) {
+	if v == nil || strings.TrimSpace(*v) == "" {
+		return nil, true
+	}
+	raw := strings.TrimSpace(*v)
+	var b strings.Builder
+	for i, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return nil, false
+		}
+	}
+	tel := b.String()
+	digits := len(strings.TrimPrefix(tel, "+"))
+	if digits < 10 || digits > 13 {
+		return nil, false
+	}
+	return &tel, true
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// DepartamentoPathItem é um degrau do caminho entre um departamento e a raiz.
+// Nivel começa em 1 na raiz da hierarquia.
+type DepartamentoPathItem struct {
+	ID      uuid.UUID       `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome    string          `json:"nome" example:"Tecnologia da Informação"`
+	Nivel   int             `json:"nivel" example:"1"`
+	Gerente *GerenteSummary `json:"gerente,omitempty"`
+}
+
+// ChainOfCommand é a cadeia de comando de um colaborador: os departamentos
+// do seu até a raiz, do mais próximo para o mais distante.
+type ChainOfCommand struct {
+	ColaboradorID  uuid.UUID              `json:"colaborador_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DepartamentoID uuid.UUID              `json:"departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Cadeia         []DepartamentoPathItem `json:"cadeia"`
+}
+
+// pathItems converte a cadeia (do departamento para a raiz) em itens com nível,
+// mantendo a mesma ordem.
+func pathItems(chain []models.Departamento) []DepartamentoPathItem {
+	items := make([]DepartamentoPathItem, 0, len(chain))
+	for i, d := range chain {
+		item := DepartamentoPathItem{
+			ID:    d.ID,
+			Nome:  d.Nome,
+			Nivel: len(chain) - i,
+		}
+		if d.Gerente != nil {
+			item.Gerente = &GerenteSummary{ID: d.Gerente.ID, Nome: d.Gerente.Nome}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// Ancestors retorna o caminho da raiz até o departamento (inclusive), no formato
+// de breadcrumb. Retorna nil se o departamento não existir.
+func (s *DepartamentoService) Ancestors(id uuid.UUID) ([]DepartamentoPathItem, error) {
+	chain, err := s.repo.Ancestors(id, 0)
+	if err != nil || len(chain) == 0 {
+		return nil, err
+	}
+	items := pathItems(chain)
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	return items, nil
+}
+
+// ChainOfCommand retorna a cadeia de comando do colaborador, do seu departamento
+// até a raiz. Retorna nil se o colaborador não existir.
+func (s *ColaboradorService) ChainOfCommand(id uuid.UUID) (*ChainOfCommand, error) {
+	colab, err := s.repo.GetByID(id)
+	if err != nil || colab == nil {
+		return nil, err
+	}
+	chain, err := s.deptRepo.Ancestors(colab.DepartamentoID, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainOfCommand{
+		ColaboradorID:  colab.ID,
+		DepartamentoID: colab.DepartamentoID,
+		Cadeia:         pathItems(chain),
+	}, nil
+}
@@ -1,35 +1,67 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strings"
 
+	dderr "github.com/danubiobwm/company-api/internal/errors"
 	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
 	"github.com/danubiobwm/company-api/internal/repositories"
 	"github.com/google/uuid"
 )
 
+// DefaultMaxDepth é a profundidade máxima da hierarquia quando nenhuma é configurada.
+const DefaultMaxDepth = 10
+
 // DepartamentoService é responsável pela lógica de negócio dos departamentos
 type DepartamentoService struct {
+	uow             *repositories.UnitOfWork
 	repo            *repositories.DepartamentoRepository
 	colaboradorRepo *repositories.ColaboradorRepository
+	lotacaoRepo     *repositories.LotacaoRepository
+	maxDepth        int
 }
 
-// NewDepartamentoService cria uma nova instância de DepartamentoService
-func NewDepartamentoService(
-	repo *repositories.DepartamentoRepository,
-	colabRepo *repositories.ColaboradorRepository,
-) *DepartamentoService {
+// NewDepartamentoService cria uma nova instância de DepartamentoService sobre a
+// unidade de trabalho. maxDepth limita os níveis da hierarquia; valores <= 0 usam
+// DefaultMaxDepth.
+func NewDepartamentoService(uow *repositories.UnitOfWork, maxDepth int) *DepartamentoService {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	repos := uow.Repositories()
 	return &DepartamentoService{
-		repo:            repo,
-		colaboradorRepo: colabRepo,
+		uow:             uow,
+		repo:            repos.Departamentos,
+		colaboradorRepo: repos.Colaboradores,
+		lotacaoRepo:     repos.Lotacoes,
+		maxDepth:        maxDepth,
 	}
 }
 
-// GetAll retorna todos os departamentos
-func (s *DepartamentoService) GetAll() ([]models.Departamento, error) {
-	return s.repo.FindAll()
+// List retorna lista paginada de departamentos com filtros
+func (s *DepartamentoService) List(filters map[string]interface{}, p pagination.Params, includeGerente bool) ([]models.Departamento, int64, error) {
+	return s.repo.List(filters, p, includeGerente)
+}
+
+// ListByCursor retorna uma página de departamentos no modo cursor e, se pedido, o total.
+func (s *DepartamentoService) ListByCursor(filters map[string]interface{}, p pagination.CursorParams, includeGerente bool) ([]models.Departamento, *pagination.Cursor, *int64, error) {
+	list, next, err := s.repo.ListByCursor(filters, p, includeGerente)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !p.WithTotal {
+		return list, next, nil, nil
+	}
+	total, err := s.repo.Count(filters)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return list, next, &total, nil
 }
 
 // GetByID retorna um departamento pelo ID
@@ -38,78 +70,574 @@ func (s *DepartamentoService) GetByID(id uuid.UUID) (*models.Departamento, error
 }
 
 // Create cria um novo departamento
-func (s *DepartamentoService) Create(d *models.Departamento) error {
+func (s *DepartamentoService) Create(ctx context.Context, d *models.Departamento) error {
 	if strings.TrimSpace(d.Nome) == "" {
-		return fmt.Errorf("nome é obrigatório")
+		return dderr.NewWithCode(dderr.CodeDepartamentoInvalid, "nome é obrigatório").
+			WithKey("departamento.nome_obrigatorio", nil).
+			WithField("nome", "required", "nome é obrigatório")
 	}
 
-	// Se gerente_id foi informado, verifica se existe
-	if d.GerenteID != nil && *d.GerenteID != uuid.Nil {
-		gerente, err := s.colaboradorRepo.GetByID(*d.GerenteID)
-		if err != nil {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	d.Version = 1
+
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		// Se gerente_id foi informado, verifica se existe
+		if d.GerenteID != nil && *d.GerenteID != uuid.Nil {
+			gerente, err := tx.Colaboradores.GetByID(*d.GerenteID)
+			if err != nil {
+				return err
+			}
+			if gerente == nil {
+				return dderr.NewWithCode(dderr.CodeDepartamentoGerenteNotFound, "gerente não encontrado")
+			}
+		}
+
+		// Se departamento superior informado, valida existência e profundidade
+		if err := s.validateHierarchy(tx.Departamentos, d.ID, d.DepartamentoSuperiorID); err != nil {
 			return err
 		}
-		if gerente == nil {
-			return fmt.Errorf("gerente não encontrado")
+
+		if err := tx.Departamentos.Create(d); err != nil {
+			return err
 		}
-	}
+		return record(ctx, tx.Auditoria, models.AuditDepartamento, d.ID, models.AuditCreate, nil, d)
+	})
+}
 
-	// Se departamento superior informado, verifica se existe
-	if d.DepartamentoSuperiorID != nil && *d.DepartamentoSuperiorID != uuid.Nil {
-		superior, err := s.repo.GetByID(*d.DepartamentoSuperiorID)
+// Update atualiza um departamento existente
+func (s *DepartamentoService) Update(ctx context.Context, d *models.Departamento) error {
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		repo := tx.Departamentos
+
+		existing, err := repo.GetByID(d.ID)
 		if err != nil {
 			return err
 		}
-		if superior == nil {
-			return fmt.Errorf("departamento superior não encontrado")
+		if existing == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
 		}
-	}
-
-	if d.ID == uuid.Nil {
-		d.ID = uuid.New()
-	}
+		if err := checkVersion(d.Version, existing.Version); err != nil {
+			return err
+		}
+		return s.update(ctx, tx, existing, d)
+	})
+}
 
-	return s.repo.Create(d)
+// departamentoPatchFields são os campos aceitos em PATCH.
+var departamentoPatchFields = map[string]patchField{
+	"nome":                     {},
+	"descricao":                {nullable: true},
+	"gerente_id":               {nullable: true},
+	"departamento_superior_id": {nullable: true},
 }
 
-// Update atualiza um departamento existente
-func (s *DepartamentoService) Update(d *models.Departamento) error {
-	existing, err := s.repo.GetByID(d.ID)
+// Patch aplica um JSON Merge Patch (RFC 7396) ao departamento e grava o resultado
+// com as mesmas validações de Update. validate recebe o departamento resultante
+// antes da gravação, para aplicar as regras de formato dos campos que a camada
+// HTTP aplica ao PUT. version, quando diferente de zero, precisa ser a versão
+// atual do registro.
+func (s *DepartamentoService) Patch(ctx context.Context, id uuid.UUID, version int, patch []byte, validate func(*models.Departamento) error) (*models.Departamento, error) {
+	var d models.Departamento
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		existing, err := tx.Departamentos.GetByIDForUpdate(id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		}
+		if err := checkVersion(version, existing.Version); err != nil {
+			return err
+		}
+		d = *existing
+		if err := applyMergePatch(&d, patch, departamentoPatchFields); err != nil {
+			return err
+		}
+		if err := validate(&d); err != nil {
+			return err
+		}
+		if err := s.update(ctx, tx, existing, &d); err != nil {
+			return err
+		}
+		d = *existing
+		return nil
+	})
 	if err != nil {
-		return err
-	}
-	if existing == nil {
-		return fmt.Errorf("departamento não encontrado")
+		return nil, err
 	}
+	return &d, nil
+}
+
+// update valida d e copia os campos editáveis para existing, o registro atual,
+// gravando-o dentro de tx.
+func (s *DepartamentoService) update(ctx context.Context, tx *repositories.Repositories, existing, d *models.Departamento) error {
+	repo := tx.Departamentos
 
 	// Se gerente informado, valida
 	if d.GerenteID != nil && *d.GerenteID != uuid.Nil {
-		gerente, err := s.colaboradorRepo.GetByID(*d.GerenteID)
+		gerente, err := tx.Colaboradores.GetByID(*d.GerenteID)
 		if err != nil {
 			return err
 		}
 		if gerente == nil {
-			return fmt.Errorf("gerente não encontrado")
+			return dderr.NewWithCode(dderr.CodeDepartamentoGerenteNotFound, "gerente não encontrado")
 		}
 	}
 
+	// Se o departamento superior mudou, valida existência, ciclos e profundidade
+	if !sameID(existing.DepartamentoSuperiorID, d.DepartamentoSuperiorID) {
+		if err := repo.LockHierarchy(); err != nil {
+			return err
+		}
+		if err := s.validateHierarchy(repo, existing.ID, d.DepartamentoSuperiorID); err != nil {
+			return err
+		}
+	}
+
+	before := *existing
 	existing.Nome = d.Nome
 	existing.Descricao = d.Descricao
 	existing.GerenteID = d.GerenteID
 	existing.DepartamentoSuperiorID = d.DepartamentoSuperiorID
+	// o gerente pré-carregado não pode sobrescrever o novo gerente_id ao salvar
+	existing.Gerente = nil
 
-	return s.repo.Update(existing)
+	if err := repo.Update(existing); err != nil {
+		return versionError(err)
+	}
+	d.Version = existing.Version
+	return record(ctx, tx.Auditoria, models.AuditDepartamento, existing.ID, models.AuditUpdate, &before, existing)
 }
 
-// Delete remove um departamento pelo ID
-func (s *DepartamentoService) Delete(id uuid.UUID) error {
-	dept, err := s.repo.GetByID(id)
+// MergeResult resume o que foi transferido em uma fusão de departamentos.
+type MergeResult struct {
+	Departamento            *models.Departamento `json:"departamento"`
+	ColaboradoresMovidos    int64                `json:"colaboradores_movidos" example:"12"`
+	SubdepartamentosMovidos int64                `json:"subdepartamentos_movidos" example:"2"`
+}
+
+// Move coloca o departamento id, com toda a sua subárvore, abaixo de parentID
+// (nil torna o departamento uma raiz). Tudo acontece em uma única transação.
+func (s *DepartamentoService) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*models.Departamento, error) {
+	var moved *models.Departamento
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		repo := tx.Departamentos
+		if err := repo.LockHierarchy(); err != nil {
+			return err
+		}
+
+		dept, err := repo.GetByID(id)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		}
+		if parentID != nil && *parentID == uuid.Nil {
+			parentID = nil
+		}
+		if err := s.validateHierarchy(repo, id, parentID); err != nil {
+			return err
+		}
+		if err := repo.SetSuperior(id, parentID); err != nil {
+			return err
+		}
+
+		if moved, err = repo.GetByID(id); err != nil {
+			return err
+		}
+		return record(ctx, tx.Auditoria, models.AuditDepartamento, id, models.AuditUpdate, dept, moved)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
+
+// MergeInto transfere todos os colaboradores e subdepartamentos diretos de sourceID
+// para targetID e remove sourceID, em uma única transação. O destino não pode estar
+// dentro da subárvore da origem, e a hierarquia resultante respeita maxDepth.
+func (s *DepartamentoService) MergeInto(ctx context.Context, sourceID, targetID uuid.UUID) (*MergeResult, error) {
+	var result *MergeResult
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		repo := tx.Departamentos
+		if err := repo.LockHierarchy(); err != nil {
+			return err
+		}
+
+		source, err := repo.GetByID(sourceID)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento de origem não encontrado").
+				WithKey("departamento.origem_nao_encontrado", nil)
+		}
+
+		if result, err = s.mergeInto(ctx, tx, source, targetID); err != nil {
+			return err
+		}
+		return repo.LogExclusao(&models.DepartamentoExclusao{
+			DepartamentoID:           source.ID,
+			Nome:                     source.Nome,
+			Estrategia:               "merge",
+			DestinoID:                &targetID,
+			Resultado:                exclusaoConcluida,
+			ColaboradoresAfetados:    result.ColaboradoresMovidos,
+			SubdepartamentosAfetados: result.SubdepartamentosMovidos,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeInto executa a fusão usando os repositórios da transação corrente.
+// Cada colaborador e subdepartamento movido e a exclusão da origem vão para a auditoria.
+func (s *DepartamentoService) mergeInto(ctx context.Context, tx *repositories.Repositories, source *models.Departamento, targetID uuid.UUID) (*MergeResult, error) {
+	repo := tx.Departamentos
+	sourceID := source.ID
+	if sourceID == targetID {
+		return nil, dderr.NewWithCode(dderr.CodeDepartamentoMergeSelf, "departamento não pode ser fundido com ele mesmo")
+	}
+	target, err := repo.GetByID(targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento de destino não encontrado").
+			WithKey("departamento.destino_nao_encontrado", nil)
+	}
+
+	// os filhos da origem passam para o destino: o destino não pode ser descendente da origem
+	chain, err := repo.AncestorIDs(targetID, s.maxDepth+1)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range chain {
+		if ancestor == sourceID {
+			return nil, dderr.NewWithCode(dderr.CodeDepartamentoCycle, "departamento de destino não pode ser um subordinado do departamento de origem").
+				WithKey("departamento.destino_subordinado", nil)
+		}
+	}
+	height, err := repo.SubtreeHeight(sourceID, s.maxDepth+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain)+height-1 > s.maxDepth {
+		return nil, s.maxDepthError()
+	}
+
+	colabs, err := tx.Colaboradores.FindByDepartamentos([]uuid.UUID{sourceID})
+	if err != nil {
+		return nil, err
+	}
+	children, err := repo.FindChildren(sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{}
+	if result.ColaboradoresMovidos, err = tx.Colaboradores.ReassignDepartamento(sourceID, targetID); err != nil {
+		return nil, err
+	}
+	if err := tx.Lotacoes.ReassignDepartamento(sourceID, targetID, today(), "fusão de departamentos"); err != nil {
+		return nil, err
+	}
+	if result.SubdepartamentosMovidos, err = repo.ReassignChildren(sourceID, targetID); err != nil {
+		return nil, err
+	}
+	if err := repo.Delete(sourceID); err != nil {
+		return nil, err
+	}
+
+	for _, c := range colabs {
+		moved := c
+		moved.DepartamentoID = targetID
+		if err := record(ctx, tx.Auditoria, models.AuditColaborador, c.ID, models.AuditUpdate, &c, &moved); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range children {
+		moved := d
+		moved.DepartamentoSuperiorID = &targetID
+		if err := record(ctx, tx.Auditoria, models.AuditDepartamento, d.ID, models.AuditUpdate, &d, &moved); err != nil {
+			return nil, err
+		}
+	}
+	if err := record(ctx, tx.Auditoria, models.AuditDepartamento, sourceID, models.AuditDelete, source, nil); err != nil {
+		return nil, err
+	}
+
+	if result.Departamento, err = repo.GetByID(targetID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Estratégias aceitas na exclusão de departamentos.
+const (
+	// DeleteRestrict recusa a exclusão se houver colaboradores ou subdepartamentos.
+	DeleteRestrict = "restrict"
+	// DeleteReassign move colaboradores e subdepartamentos para outro departamento antes de excluir.
+	DeleteReassign = "reassign"
+	// DeleteCascade exclui o departamento, toda a sua subárvore e os colaboradores lotados nela.
+	DeleteCascade = "cascade"
+)
+
+const (
+	exclusaoConcluida = "concluida"
+	exclusaoRecusada  = "recusada"
+)
+
+// DeleteOptions define como a exclusão trata colaboradores e subdepartamentos.
+// Strategy vazia equivale a DeleteRestrict; TargetID é obrigatório com DeleteReassign.
+// Version, quando diferente de zero, precisa ser a versão atual do departamento.
+type DeleteOptions struct {
+	Strategy string
+	TargetID *uuid.UUID
+	Version  int
+}
+
+// Delete remove um departamento pelo ID conforme a estratégia escolhida.
+// Toda tentativa, concluída ou recusada, fica registrada em departamento_exclusoes.
+func (s *DepartamentoService) Delete(ctx context.Context, id uuid.UUID, opts DeleteOptions) error {
+	if opts.Strategy == "" {
+		opts.Strategy = DeleteRestrict
+	}
+	switch opts.Strategy {
+	case DeleteRestrict, DeleteCascade:
+	case DeleteReassign:
+		if opts.TargetID == nil || *opts.TargetID == uuid.Nil {
+			return dderr.NewWithCode(dderr.CodeInvalidDeleteStrategy, "target é obrigatório com strategy=reassign").
+				WithKey("departamento.target_obrigatorio", nil).
+				WithField("target", "required", "target é obrigatório com strategy=reassign")
+		}
+	default:
+		return dderr.NewWithCode(dderr.CodeInvalidDeleteStrategy, "strategy deve ser restrict, reassign ou cascade").
+			WithKey("departamento.strategy_invalida", nil).
+			WithFieldParam("strategy", "oneof", "restrict reassign cascade", "strategy deve ser restrict, reassign ou cascade")
+	}
+
+	var registro models.DepartamentoExclusao
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		repo := tx.Departamentos
+		if err := repo.LockHierarchy(); err != nil {
+			return err
+		}
+
+		dept, err := repo.GetByIDForUpdate(id)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		}
+		if err := checkVersion(opts.Version, dept.Version); err != nil {
+			return err
+		}
+		registro = models.DepartamentoExclusao{
+			DepartamentoID: dept.ID,
+			Nome:           dept.Nome,
+			Estrategia:     opts.Strategy,
+			DestinoID:      opts.TargetID,
+		}
+
+		switch opts.Strategy {
+		case DeleteRestrict:
+			colabs, err := tx.Colaboradores.Count(map[string]interface{}{"departamento_id": id.String(), "include_desligados": true})
+			if err != nil {
+				return err
+			}
+			children, err := repo.Count(map[string]interface{}{"departamento_superior_id": id.String()})
+			if err != nil {
+				return err
+			}
+			registro.ColaboradoresAfetados, registro.SubdepartamentosAfetados = colabs, children
+			if colabs > 0 || children > 0 {
+				return dderr.NewWithCode(dderr.CodeDepartamentoNotEmpty, "departamento possui colaboradores ou subdepartamentos").
+					WithDetails(map[string]interface{}{
+						"colaboradores":    colabs,
+						"subdepartamentos": children,
+					})
+			}
+			if err := repo.Delete(id); err != nil {
+				return err
+			}
+			if err := record(ctx, tx.Auditoria, models.AuditDepartamento, id, models.AuditDelete, dept, nil); err != nil {
+				return err
+			}
+
+		case DeleteReassign:
+			result, err := s.mergeInto(ctx, tx, dept, *opts.TargetID)
+			if err != nil {
+				return err
+			}
+			registro.ColaboradoresAfetados = result.ColaboradoresMovidos
+			registro.SubdepartamentosAfetados = result.SubdepartamentosMovidos
+
+		case DeleteCascade:
+			depts, err := repo.Descendants(id)
+			if err != nil {
+				return err
+			}
+			ids := make([]uuid.UUID, 0, len(depts))
+			for _, d := range depts {
+				ids = append(ids, d.ID)
+			}
+			colabs, err := tx.Colaboradores.FindByDepartamentos(ids)
+			if err != nil {
+				return err
+			}
+			for i := range colabs {
+				if err := detachColaborador(ctx, tx, colabs[i].ID); err != nil {
+					return err
+				}
+			}
+			if registro.ColaboradoresAfetados, err = tx.Colaboradores.DeleteByDepartamentos(ids); err != nil {
+				return err
+			}
+			deleted, err := repo.DeleteMany(ids)
+			if err != nil {
+				return err
+			}
+			registro.SubdepartamentosAfetados = deleted - 1
+
+			for i := range colabs {
+				if err := record(ctx, tx.Auditoria, models.AuditColaborador, colabs[i].ID, models.AuditDelete, &colabs[i], nil); err != nil {
+					return err
+				}
+			}
+			for i := range depts {
+				if err := record(ctx, tx.Auditoria, models.AuditDepartamento, depts[i].ID, models.AuditDelete, &depts[i], nil); err != nil {
+					return err
+				}
+			}
+		}
+
+		registro.Resultado = exclusaoConcluida
+		return repo.LogExclusao(&registro)
+	})
+
+	// a recusa desfaz a transação, então é registrada à parte
+	var domainErr *dderr.DomainError
+	if errors.As(err, &domainErr) && domainErr.Code == dderr.CodeDepartamentoNotEmpty {
+		registro.Resultado = exclusaoRecusada
+		if logErr := s.repo.LogExclusao(&registro); logErr != nil {
+			log.Printf("warning: falha ao registrar exclusão recusada do departamento %s: %v", id, logErr)
+		}
+	}
+	return err
+}
+
+// GetByIDUnscoped retorna um departamento pelo ID, incluindo os excluídos logicamente.
+func (s *DepartamentoService) GetByIDUnscoped(id uuid.UUID) (*models.Departamento, error) {
+	return s.repo.GetByIDUnscoped(id)
+}
+
+// Restore desfaz a exclusão lógica de um departamento. O superior gravado precisa
+// continuar ativo e a hierarquia resultante precisa respeitar as mesmas regras de
+// uma movimentação. Colaboradores e subdepartamentos excluídos junto não voltam.
+func (s *DepartamentoService) Restore(ctx context.Context, id uuid.UUID) (*models.Departamento, error) {
+	var restored *models.Departamento
+	err := s.uow.Do(func(tx *repositories.Repositories) error {
+		repo := tx.Departamentos
+		if err := repo.LockHierarchy(); err != nil {
+			return err
+		}
+
+		dept, err := repo.GetByIDUnscoped(id)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotFound, "departamento não encontrado")
+		}
+		if !dept.DeletedAt.Valid {
+			return dderr.NewWithCode(dderr.CodeDepartamentoNotDeleted, "departamento não está excluído")
+		}
+		if err := repo.Restore(id); err != nil {
+			return err
+		}
+		if err := s.validateHierarchy(repo, id, dept.DepartamentoSuperiorID); err != nil {
+			return err
+		}
+		if err := record(ctx, tx.Auditoria, models.AuditDepartamento, id, models.AuditRestore, nil, nil); err != nil {
+			return err
+		}
+
+		restored, err = repo.GetByID(id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// validateHierarchy garante que o departamento id possa ficar abaixo de parentID:
+// o superior precisa existir, não pode ser o próprio departamento nem um de seus
+// descendentes, e a árvore resultante não pode passar de maxDepth níveis.
+// id pode ser uuid.Nil para um departamento ainda não criado.
+func (s *DepartamentoService) validateHierarchy(repo *repositories.DepartamentoRepository, id uuid.UUID, parentID *uuid.UUID) error {
+	if parentID == nil || *parentID == uuid.Nil {
+		return nil
+	}
+	if id != uuid.Nil && *parentID == id {
+		return dderr.NewWithCode(dderr.CodeDepartamentoSelfParent, "departamento não pode ser superior de si mesmo")
+	}
+
+	parent, err := repo.GetByID(*parentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return dderr.NewWithCode(dderr.CodeDepartamentoSuperiorNotFound, "departamento superior não encontrado")
+	}
+
+	// cadeia do novo superior até a raiz; se o próprio departamento aparecer nela, haveria ciclo
+	chain, err := repo.AncestorIDs(*parentID, s.maxDepth+1)
 	if err != nil {
 		return err
 	}
-	if dept == nil {
-		return errors.New("departamento não encontrado")
+	for _, ancestor := range chain {
+		if ancestor == id {
+			return dderr.NewWithCode(dderr.CodeDepartamentoCycle, "departamento superior não pode ser um subordinado do próprio departamento").
+				WithKey("departamento.superior_subordinado", nil)
+		}
+	}
+
+	height := 1
+	if id != uuid.Nil {
+		if height, err = repo.SubtreeHeight(id, s.maxDepth+1); err != nil {
+			return err
+		}
+		if height < 1 {
+			height = 1
+		}
+	}
+	if len(chain)+height > s.maxDepth {
+		return s.maxDepthError()
+	}
+	return nil
+}
+
+// sameID compara dois ids opcionais, tratando uuid.Nil como ausente.
+func sameID(a, b *uuid.UUID) bool {
+	aNil := a == nil || *a == uuid.Nil
+	bNil := b == nil || *b == uuid.Nil
+	if aNil || bNil {
+		return aNil == bNil
 	}
+	return *a == *b
+}
 
-	return s.repo.Delete(id)
+// maxDepthError é o erro de uma hierarquia que passaria de maxDepth níveis. O
+// limite vai em max_depth para o cliente e para a mensagem traduzida.
+func (s *DepartamentoService) maxDepthError() error {
+	return dderr.NewWithCode(dderr.CodeDepartamentoMaxDepth, fmt.Sprintf("hierarquia de departamentos não pode ter mais de %d níveis", s.maxDepth)).
+		WithDetails(map[string]interface{}{"max_depth": s.maxDepth})
 }
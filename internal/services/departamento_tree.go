@@ -0,0 +1,180 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// GerenteSummary é a forma resumida do gerente exibida nos nós da árvore.
+type GerenteSummary struct {
+	ID   uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome string    `json:"nome" example:"João Silva"`
+}
+
+// DepartamentoNode é um departamento dentro da árvore organizacional.
+// HeadcountDireto conta os colaboradores do próprio departamento e HeadcountTotal
+// inclui todos os subdepartamentos, mesmo os cortados pelo limite de profundidade.
+type DepartamentoNode struct {
+	ID                     uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome                   string               `json:"nome" example:"Tecnologia da Informação"`
+	Descricao              *string              `json:"descricao,omitempty"`
+	DepartamentoSuperiorID *uuid.UUID           `json:"departamento_superior_id,omitempty"`
+	Gerente                *GerenteSummary      `json:"gerente,omitempty"`
+	HeadcountDireto        int64                `json:"headcount_direto" example:"4"`
+	HeadcountTotal         int64                `json:"headcount_total" example:"12"`
+	Colaboradores          []models.Colaborador `json:"colaboradores,omitempty"`
+	Subdepartamentos       []*DepartamentoNode  `json:"subdepartamentos"`
+}
+
+// buildTree monta a floresta de departamentos a partir da lista plana.
+// Departamentos sem superior, ou cujo superior não está na lista, viram raízes.
+// Retorna as raízes ordenadas por nome e um índice de todos os nós por id.
+func buildTree(depts []models.Departamento, headcounts map[uuid.UUID]int64) ([]*DepartamentoNode, map[uuid.UUID]*DepartamentoNode) {
+	nodes := make(map[uuid.UUID]*DepartamentoNode, len(depts))
+	for _, d := range depts {
+		node := &DepartamentoNode{
+			ID:                     d.ID,
+			Nome:                   d.Nome,
+			Descricao:              d.Descricao,
+			DepartamentoSuperiorID: d.DepartamentoSuperiorID,
+			HeadcountDireto:        headcounts[d.ID],
+			Subdepartamentos:       []*DepartamentoNode{},
+		}
+		if d.Gerente != nil {
+			node.Gerente = &GerenteSummary{ID: d.Gerente.ID, Nome: d.Gerente.Nome}
+		}
+		nodes[d.ID] = node
+	}
+
+	var roots []*DepartamentoNode
+	for _, d := range depts {
+		node := nodes[d.ID]
+		if d.DepartamentoSuperiorID != nil {
+			if parent, ok := nodes[*d.DepartamentoSuperiorID]; ok && parent != node {
+				parent.Subdepartamentos = append(parent.Subdepartamentos, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	visited := make(map[uuid.UUID]bool, len(nodes))
+	for _, root := range roots {
+		sumHeadcount(root, visited)
+	}
+	sortNodes(roots)
+	return roots, nodes
+}
+
+// sumHeadcount preenche HeadcountTotal e ordena os filhos. visited evita laços
+// caso a base contenha um ciclo gravado antes da validação de hierarquia.
+func sumHeadcount(node *DepartamentoNode, visited map[uuid.UUID]bool) int64 {
+	if visited[node.ID] {
+		return 0
+	}
+	visited[node.ID] = true
+
+	total := node.HeadcountDireto
+	for _, child := range node.Subdepartamentos {
+		total += sumHeadcount(child, visited)
+	}
+	node.HeadcountTotal = total
+	sortNodes(node.Subdepartamentos)
+	return total
+}
+
+func sortNodes(nodes []*DepartamentoNode) {
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].Nome < nodes[j].Nome })
+}
+
+// pruneTree corta a árvore para que tenha no máximo depth níveis (depth <= 0 não corta)
+// e devolve os ids dos departamentos que permaneceram. Um nó já visitado nunca é
+// repetido, o que também quebra eventuais ciclos antes da serialização.
+func pruneTree(roots []*DepartamentoNode, depth int) []uuid.UUID {
+	var ids []uuid.UUID
+	visited := make(map[uuid.UUID]bool)
+	var walk func(nodes []*DepartamentoNode, level int) []*DepartamentoNode
+	walk = func(nodes []*DepartamentoNode, level int) []*DepartamentoNode {
+		kept := []*DepartamentoNode{}
+		for _, n := range nodes {
+			if visited[n.ID] {
+				continue
+			}
+			visited[n.ID] = true
+			ids = append(ids, n.ID)
+			kept = append(kept, n)
+			if depth > 0 && level >= depth {
+				n.Subdepartamentos = []*DepartamentoNode{}
+				continue
+			}
+			n.Subdepartamentos = walk(n.Subdepartamentos, level+1)
+		}
+		return kept
+	}
+	walk(roots, 1)
+	return ids
+}
+
+// Tree retorna a árvore organizacional. Sem rootID retorna todas as raízes;
+// com rootID retorna apenas a subárvore daquele departamento (nil se não existir).
+// depth limita os níveis retornados e includeColaboradores anexa as pessoas de cada nó.
+// Com asOf, a árvore é reconstruída como estava ao fim daquela data, a partir das
+// versões dos departamentos e do histórico de lotações.
+func (s *DepartamentoService) Tree(rootID *uuid.UUID, depth int, includeColaboradores bool, asOf *time.Time) ([]*DepartamentoNode, error) {
+	var (
+		depts      []models.Departamento
+		headcounts map[uuid.UUID]int64
+		err        error
+	)
+	if asOf != nil {
+		if depts, err = s.repo.FindAllAsOf(endOfDay(*asOf)); err != nil {
+			return nil, err
+		}
+		headcounts, err = s.lotacaoRepo.CountByDepartamentoOn(*asOf)
+	} else {
+		if depts, err = s.repo.FindAll(); err != nil {
+			return nil, err
+		}
+		headcounts, err = s.colaboradorRepo.CountByDepartamento()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roots, nodes := buildTree(depts, headcounts)
+	if rootID != nil {
+		node, ok := nodes[*rootID]
+		if !ok {
+			return nil, nil
+		}
+		roots = []*DepartamentoNode{node}
+	}
+
+	ids := pruneTree(roots, depth)
+	if includeColaboradores {
+		var colabs []models.Colaborador
+		if asOf != nil {
+			colabs, err = s.lotacaoRepo.ColaboradoresOn(ids, *asOf)
+		} else {
+			colabs, err = s.colaboradorRepo.ListByDepartamentos(ids)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range colabs {
+			if node, ok := nodes[c.DepartamentoID]; ok {
+				node.Colaboradores = append(node.Colaboradores, c)
+			}
+		}
+	}
+	return roots, nil
+}
+
+// endOfDay retorna o último instante da data d, usado para consultar as versões
+// dos departamentos "como estavam naquele dia".
+func endOfDay(d time.Time) time.Time {
+	return d.AddDate(0, 0, 1).Add(-time.Microsecond)
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTreeHeadcountsAndDepth(t *testing.T) {
+	root, ti, infra, rh := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	gerente := &models.Colaborador{ID: uuid.New(), Nome: "João Silva"}
+
+	depts := []models.Departamento{
+		{ID: infra, Nome: "Infraestrutura", DepartamentoSuperiorID: &ti},
+		{ID: root, Nome: "Diretoria"},
+		{ID: rh, Nome: "Recursos Humanos", DepartamentoSuperiorID: &root},
+		{ID: ti, Nome: "Tecnologia", DepartamentoSuperiorID: &root, Gerente: gerente},
+	}
+	headcounts := map[uuid.UUID]int64{root: 1, ti: 2, infra: 5, rh: 3}
+
+	roots, nodes := buildTree(depts, headcounts)
+	require.Len(t, roots, 1)
+	assert.Equal(t, int64(11), roots[0].HeadcountTotal)
+	assert.Equal(t, int64(1), roots[0].HeadcountDireto)
+	assert.Equal(t, "Recursos Humanos", roots[0].Subdepartamentos[0].Nome)
+	assert.Equal(t, int64(7), nodes[ti].HeadcountTotal)
+	assert.Equal(t, "João Silva", nodes[ti].Gerente.Nome)
+
+	ids := pruneTree(roots, 2)
+	assert.ElementsMatch(t, []uuid.UUID{root, rh, ti}, ids)
+	assert.Empty(t, nodes[ti].Subdepartamentos)
+	assert.Equal(t, int64(7), nodes[ti].HeadcountTotal)
+}
+
+func TestBuildTreeSurvivesCycles(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	depts := []models.Departamento{
+		{ID: a, Nome: "A", DepartamentoSuperiorID: &b},
+		{ID: b, Nome: "B", DepartamentoSuperiorID: &a},
+	}
+
+	roots, nodes := buildTree(depts, nil)
+	assert.Empty(t, roots)
+
+	ids := pruneTree([]*DepartamentoNode{nodes[a]}, 0)
+	assert.ElementsMatch(t, []uuid.UUID{a, b}, ids)
+	assert.Empty(t, nodes[b].Subdepartamentos)
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/pagination"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// GerenteService reúne as consultas sobre a hierarquia chefiada por um gerente.
+type GerenteService struct {
+	deptRepo    *repositories.DepartamentoRepository
+	colabRepo   *repositories.ColaboradorRepository
+	lotacaoRepo *repositories.LotacaoRepository
+}
+
+// NewGerenteService cria uma nova instância de GerenteService
+func NewGerenteService(dr *repositories.DepartamentoRepository, cr *repositories.ColaboradorRepository, lr *repositories.LotacaoRepository) *GerenteService {
+	return &GerenteService{deptRepo: dr, colabRepo: cr, lotacaoRepo: lr}
+}
+
+// DepartamentoNaHierarquia é um departamento alcançado a partir de um dos
+// departamentos chefiados. Nivel é 0 no próprio departamento chefiado.
+type DepartamentoNaHierarquia struct {
+	models.Departamento
+	ViaDepartamentoID uuid.UUID
+	Nivel             int
+}
+
+// ColaboradorNaHierarquia é um colaborador alcançado a partir de um dos
+// departamentos chefiados pelo gerente.
+type ColaboradorNaHierarquia struct {
+	models.Colaborador
+	ViaDepartamentoID uuid.UUID
+}
+
+// GerenteHierarchy é a união das hierarquias de todos os departamentos chefiados,
+// com uma página dos colaboradores encontrados.
+type GerenteHierarchy struct {
+	GerenteID                uuid.UUID
+	DepartamentosGerenciados []models.Departamento
+	Departamentos            []DepartamentoNaHierarquia
+	Colaboradores            []ColaboradorNaHierarquia
+	Total                    int64
+}
+
+// Colaboradores retorna a hierarquia de todos os departamentos chefiados pelo gerente
+// e uma página dos colaboradores lotados nela. Subárvores sobrepostas aparecem uma
+// única vez e cada item informa por qual departamento chefiado foi alcançado.
+// Além dos filtros de colaborador, aceita "via_departamento_id" para restringir a
+// busca à subárvore de um dos departamentos chefiados.
+// Com asOf, departamentos, chefias e lotações são os vigentes ao fim daquela data.
+func (s *GerenteService) Colaboradores(gerenteID uuid.UUID, filters map[string]interface{}, p pagination.Params, asOf *time.Time) (*GerenteHierarchy, error) {
+	managed, subtrees, err := s.subtrees(gerenteID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if len(managed) == 0 {
+		return nil, dderr.NewWithCode(dderr.CodeGerenteSemDepartamento, "gerente não vinculado a nenhum departamento")
+	}
+	depts := unionSubtrees(subtrees)
+
+	via, _ := filters["via_departamento_id"].(string)
+	viaByDept := make(map[uuid.UUID]uuid.UUID, len(depts))
+	ids := make([]uuid.UUID, 0, len(depts))
+	for _, d := range depts {
+		viaByDept[d.ID] = d.ViaDepartamentoID
+		if via == "" || d.ViaDepartamentoID.String() == via {
+			ids = append(ids, d.ID)
+		}
+	}
+
+	colabFilters := make(map[string]interface{}, len(filters)+1)
+	for k, v := range filters {
+		colabFilters[k] = v
+	}
+	colabFilters["departamento_ids"] = ids
+	if asOf != nil {
+		colabFilters["as_of"] = *asOf
+	}
+
+	colabs, total, err := s.colabRepo.List(colabFilters, p)
+	if err != nil {
+		return nil, err
+	}
+	if asOf != nil {
+		// o departamento exibido é o da data consultada, não o atual
+		colabIDs := make([]uuid.UUID, 0, len(colabs))
+		for _, c := range colabs {
+			colabIDs = append(colabIDs, c.ID)
+		}
+		lotacoes, err := s.lotacaoRepo.DepartamentosOn(colabIDs, *asOf)
+		if err != nil {
+			return nil, err
+		}
+		for i := range colabs {
+			colabs[i].DepartamentoID = lotacoes[colabs[i].ID]
+		}
+	}
+
+	result := &GerenteHierarchy{
+		GerenteID:                gerenteID,
+		DepartamentosGerenciados: managed,
+		Departamentos:            depts,
+		Colaboradores:            make([]ColaboradorNaHierarquia, 0, len(colabs)),
+		Total:                    total,
+	}
+	for _, c := range colabs {
+		result.Colaboradores = append(result.Colaboradores, ColaboradorNaHierarquia{
+			Colaborador:       c,
+			ViaDepartamentoID: viaByDept[c.DepartamentoID],
+		})
+	}
+	return result, nil
+}
+
+// subtrees retorna os departamentos chefiados pelo gerente e a subárvore de cada um,
+// no estado atual ou, com asOf, como estavam ao fim daquela data.
+func (s *GerenteService) subtrees(gerenteID uuid.UUID, asOf *time.Time) ([]models.Departamento, [][]models.Departamento, error) {
+	if asOf != nil {
+		all, err := s.deptRepo.FindAllAsOf(endOfDay(*asOf))
+		if err != nil {
+			return nil, nil, err
+		}
+		var managed []models.Departamento
+		var subtrees [][]models.Departamento
+		for _, d := range all {
+			if d.GerenteID != nil && *d.GerenteID == gerenteID {
+				managed = append(managed, d)
+			}
+		}
+		sort.SliceStable(managed, func(i, j int) bool { return managed[i].Nome < managed[j].Nome })
+		for _, d := range managed {
+			subtrees = append(subtrees, descendantsOf(all, d.ID))
+		}
+		return managed, subtrees, nil
+	}
+
+	managed, err := s.deptRepo.FindByGerente(gerenteID)
+	if err != nil {
+		return nil, nil, err
+	}
+	subtrees := make([][]models.Departamento, 0, len(managed))
+	for _, d := range managed {
+		descendants, err := s.deptRepo.Descendants(d.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		subtrees = append(subtrees, descendants)
+	}
+	return managed, subtrees, nil
+}
+
+// descendantsOf percorre a lista plana a partir de rootID e retorna o próprio
+// departamento seguido dos descendentes, por nível e nome, como
+// DepartamentoRepository.Descendants. Ciclos são ignorados.
+func descendantsOf(all []models.Departamento, rootID uuid.UUID) []models.Departamento {
+	byID := make(map[uuid.UUID]models.Departamento, len(all))
+	children := make(map[uuid.UUID][]models.Departamento)
+	for _, d := range all {
+		byID[d.ID] = d
+		if d.DepartamentoSuperiorID != nil {
+			children[*d.DepartamentoSuperiorID] = append(children[*d.DepartamentoSuperiorID], d)
+		}
+	}
+	root, ok := byID[rootID]
+	if !ok {
+		return nil
+	}
+
+	result := []models.Departamento{root}
+	visited := map[uuid.UUID]bool{rootID: true}
+	level := []models.Departamento{root}
+	for len(level) > 0 {
+		var next []models.Departamento
+		for _, d := range level {
+			for _, child := range children[d.ID] {
+				if visited[child.ID] {
+					continue
+				}
+				visited[child.ID] = true
+				next = append(next, child)
+			}
+		}
+		sort.SliceStable(next, func(i, j int) bool { return next[i].Nome < next[j].Nome })
+		result = append(result, next...)
+		level = next
+	}
+	return result
+}
+
+// unionSubtrees une as subárvores (cada uma começando pelo departamento chefiado,
+// em ordem de nível) sem repetir departamentos. Quando um departamento aparece em
+// mais de uma subárvore, fica com a raiz mais próxima; no empate, com a primeira.
+func unionSubtrees(subtrees [][]models.Departamento) []DepartamentoNaHierarquia {
+	index := make(map[uuid.UUID]int)
+	var result []DepartamentoNaHierarquia
+
+	for _, subtree := range subtrees {
+		if len(subtree) == 0 {
+			continue
+		}
+		root := subtree[0].ID
+		levels := map[uuid.UUID]int{root: 0}
+		for _, d := range subtree {
+			level := 0
+			if d.ID != root && d.DepartamentoSuperiorID != nil {
+				level = levels[*d.DepartamentoSuperiorID] + 1
+			}
+			levels[d.ID] = level
+
+			item := DepartamentoNaHierarquia{Departamento: d, ViaDepartamentoID: root, Nivel: level}
+			if i, seen := index[d.ID]; seen {
+				if level < result[i].Nivel {
+					result[i] = item
+				}
+				continue
+			}
+			index[d.ID] = len(result)
+			result = append(result, item)
+		}
+	}
+	return result
+}
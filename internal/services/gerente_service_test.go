@@ -0,0 +1,67 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionSubtreesKeepsNearestManagedRoot(t *testing.T) {
+	diretoria, ti, infra, redes := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	vendas := uuid.New()
+
+	// o gerente chefia Diretoria, TI (dentro de Diretoria) e Vendas (árvore separada)
+	subtrees := [][]models.Departamento{
+		{
+			{ID: diretoria, Nome: "Diretoria"},
+			{ID: ti, Nome: "TI", DepartamentoSuperiorID: &diretoria},
+			{ID: infra, Nome: "Infra", DepartamentoSuperiorID: &ti},
+			{ID: redes, Nome: "Redes", DepartamentoSuperiorID: &infra},
+		},
+		{
+			{ID: ti, Nome: "TI", DepartamentoSuperiorID: &diretoria},
+			{ID: infra, Nome: "Infra", DepartamentoSuperiorID: &ti},
+			{ID: redes, Nome: "Redes", DepartamentoSuperiorID: &infra},
+		},
+		{
+			{ID: vendas, Nome: "Vendas"},
+		},
+	}
+
+	depts := unionSubtrees(subtrees)
+	require.Len(t, depts, 5)
+
+	byID := make(map[uuid.UUID]DepartamentoNaHierarquia)
+	for _, d := range depts {
+		byID[d.ID] = d
+	}
+	assert.Equal(t, diretoria, byID[diretoria].ViaDepartamentoID)
+	assert.Equal(t, ti, byID[ti].ViaDepartamentoID)
+	assert.Equal(t, 0, byID[ti].Nivel)
+	assert.Equal(t, ti, byID[redes].ViaDepartamentoID)
+	assert.Equal(t, 2, byID[redes].Nivel)
+	assert.Equal(t, vendas, byID[vendas].ViaDepartamentoID)
+}
+
+func TestDescendantsOfOrdersByLevelAndName(t *testing.T) {
+	root, b, a, leaf, other := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	all := []models.Departamento{
+		{ID: leaf, Nome: "Folha", DepartamentoSuperiorID: &a},
+		{ID: b, Nome: "B", DepartamentoSuperiorID: &root},
+		{ID: root, Nome: "Raiz"},
+		{ID: a, Nome: "A", DepartamentoSuperiorID: &root},
+		{ID: other, Nome: "Outro"},
+	}
+
+	got := descendantsOf(all, root)
+
+	ids := make([]uuid.UUID, 0, len(got))
+	for _, d := range got {
+		ids = append(ids, d.ID)
+	}
+	assert.Equal(t, []uuid.UUID{root, a, b, leaf}, ids)
+	assert.Nil(t, descendantsOf(all, uuid.New()))
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// TransferResult é o resultado de uma transferência: imediata, quando a data
+// efetiva já chegou, ou agendada para o futuro.
+type TransferResult struct {
+	Colaborador *models.Colaborador       `json:"colaborador"`
+	Lotacao     models.ColaboradorLotacao `json:"lotacao"`
+	Agendada    bool                      `json:"agendada" example:"false"`
+}
+
+// LotacaoItem é um período do histórico de lotação do colaborador.
+type LotacaoItem struct {
+	DepartamentoID   uuid.UUID  `json:"departamento_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DepartamentoNome string     `json:"departamento_nome" example:"Tecnologia da Informação"`
+	ValidFrom        time.Time  `json:"valid_from"`
+	ValidTo          *time.Time `json:"valid_to,omitempty"`
+	Motivo           *string    `json:"motivo,omitempty"`
+	Agendada         bool       `json:"agendada" example:"false"`
+}
+
+// ColaboradorHistory é o histórico de lotações do colaborador. Com AsOf preenchido
+// contém apenas a lotação vigente naquela data.
+type ColaboradorHistory struct {
+	ColaboradorID uuid.UUID     `json:"colaborador_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	AsOf          *time.Time    `json:"as_of,omitempty"`
+	Lotacoes      []LotacaoItem `json:"lotacoes"`
+}
+
+// transferenciaAgendada é como uma transferência agendada aparece na auditoria.
+func transferenciaAgendada(l *models.ColaboradorLotacao) map[string]interface{} {
+	if l == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"departamento_agendado_id": l.DepartamentoID,
+		"data_transferencia":       l.ValidFrom.Format("2006-01-02"),
+	}
+}
+
+// Transfer transfere o colaborador para o departamento a partir de effective
+// (hoje, se nil). Datas futuras ficam agendadas e são efetivadas pelo job de
+// transferências; uma nova transferência substitui a que estiver agendada.
+func (s *ColaboradorService) Transfer(ctx context.Context, id, departamentoID uuid.UUID, effective *time.Time, motivo string) (*TransferResult, error) {
+	colab, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if colab == nil {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+	}
+	if colab.Status == models.StatusDesligado {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorTerminated, "colaborador desligado não pode ser transferido").
+			WithKey("colaborador.desligado_transferencia", nil)
+	}
+	if colab.DepartamentoID == departamentoID {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorTransferInvalid, "colaborador já está lotado no departamento").
+			WithKey("transferencia.mesmo_departamento", nil).
+			WithField("departamento_id", "unchanged", "colaborador já está lotado no departamento")
+	}
+	dept, err := s.deptRepo.GetByID(departamentoID)
+	if err != nil {
+		return nil, err
+	}
+	if dept == nil {
+		return nil, dderr.NewWithCode(dderr.CodeColaboradorDepartamentoNotFound, "departamento não existe")
+	}
+
+	hoje := today()
+	date := hoje
+	if effective != nil {
+		date = *effective
+	}
+	var reason *string
+	if motivo != "" {
+		reason = &motivo
+	}
+
+	result := &TransferResult{Colaborador: colab, Agendada: date.After(hoje)}
+	err = s.uow.Do(func(tx *repositories.Repositories) error {
+		lotacao, err := applyTransfer(tx.Lotacoes, id, departamentoID, date, hoje, reason)
+		if err != nil {
+			return err
+		}
+		result.Lotacao = *lotacao
+		if result.Agendada {
+			return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditUpdate, nil, transferenciaAgendada(lotacao))
+		}
+		before := *colab
+		colab.DepartamentoID = departamentoID
+		if err := tx.Colaboradores.Update(colab); err != nil {
+			return versionError(err)
+		}
+		return record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditUpdate, &before, colab)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ApplyDueTransfers efetiva as transferências agendadas vigentes em on: atualiza o
+// departamento atual de cada colaborador e registra a alteração na auditoria na
+// mesma transação. Sem ator em ctx, a auditoria registra o sistema. Retorna quantos
+// colaboradores foram transferidos.
+func (s *ColaboradorService) ApplyDueTransfers(ctx context.Context, on time.Time) (int64, error) {
+	due, err := s.lotacaoRepo.Due(on)
+	if err != nil {
+		return 0, err
+	}
+	var applied int64
+	for _, l := range due {
+		changed := false
+		err := s.uow.Do(func(tx *repositories.Repositories) error {
+			colab, err := tx.Colaboradores.GetByIDForUpdate(l.ColaboradorID)
+			if err != nil || colab == nil {
+				return err
+			}
+			// o colaborador ou a lotação podem ter mudado desde a consulta
+			current, err := tx.Lotacoes.Current(colab.ID, on)
+			if err != nil || current == nil || current.DepartamentoID != l.DepartamentoID {
+				return err
+			}
+			if colab.Status == models.StatusDesligado || colab.DepartamentoID == l.DepartamentoID {
+				return nil
+			}
+			before := *colab
+			colab.DepartamentoID = l.DepartamentoID
+			if err := tx.Colaboradores.Update(colab); err != nil {
+				return versionError(err)
+			}
+			changed = true
+			return record(ctx, tx.Auditoria, models.AuditColaborador, colab.ID, models.AuditUpdate, &before, colab)
+		})
+		if err != nil {
+			return applied, err
+		}
+		if changed {
+			applied++
+		}
+	}
+	return applied, nil
+}
+
+// CancelScheduledTransfer desfaz a transferência agendada do colaborador.
+func (s *ColaboradorService) CancelScheduledTransfer(ctx context.Context, id uuid.UUID) error {
+	colab, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if colab == nil {
+		return dderr.NewWithCode(dderr.CodeColaboradorNotFound, "colaborador não encontrado")
+	}
+
+	hoje := today()
+	return s.uow.Do(func(tx *repositories.Repositories) error {
+		list, err := tx.Lotacoes.ListByColaborador(id)
+		if err != nil {
+			return err
+		}
+		var agendada *models.ColaboradorLotacao
+		for i := range list {
+			if list[i].ValidFrom.After(hoje) {
+				agendada = &list[i].ColaboradorLotacao
+				break
+			}
+		}
+		removed, err := tx.Lotacoes.DeleteAfter(id, hoje)
+		if err != nil {
+			return err
+		}
+		if removed == 0 {
+			return dderr.NewWithCode(dderr.CodeColaboradorTransferNotScheduled, "colaborador não tem transferência agendada")
+		}
+		if err := record(ctx, tx.Auditoria, models.AuditColaborador, id, models.AuditUpdate, transferenciaAgendada(agendada), nil); err != nil {
+			return err
+		}
+		current, err := tx.Lotacoes.Current(id, hoje)
+		if err != nil || current == nil {
+			return err
+		}
+		current.ValidTo = nil
+		return tx.Lotacoes.Update(current)
+	})
+}
+
+// History retorna o histórico de lotações do colaborador. Com asOf, responde
+// onde o colaborador estava lotado naquela data.
+func (s *ColaboradorService) History(id uuid.UUID, asOf *time.Time) (*ColaboradorHistory, error) {
+	colab, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if colab == nil {
+		return nil, nil
+	}
+	list, err := s.lotacaoRepo.ListByColaborador(id)
+	if err != nil {
+		return nil, err
+	}
+	if asOf != nil {
+		list = lotacoesOn(list, *asOf)
+	}
+
+	hoje := today()
+	history := &ColaboradorHistory{ColaboradorID: id, AsOf: asOf, Lotacoes: make([]LotacaoItem, 0, len(list))}
+	for _, l := range list {
+		history.Lotacoes = append(history.Lotacoes, LotacaoItem{
+			DepartamentoID:   l.DepartamentoID,
+			DepartamentoNome: l.DepartamentoNome,
+			ValidFrom:        l.ValidFrom,
+			ValidTo:          l.ValidTo,
+			Motivo:           l.Motivo,
+			Agendada:         l.ValidFrom.After(hoje),
+		})
+	}
+	return history, nil
+}
+
+// applyTransfer grava a transferência no histórico: remove a transferência que
+// estiver agendada, encerra a lotação vigente em date e abre a nova. Uma
+// transferência na mesma data de início da lotação vigente apenas a corrige.
+// Não altera o departamento atual do colaborador; isso cabe a quem chama.
+func applyTransfer(lotacoes *repositories.LotacaoRepository, colaboradorID, departamentoID uuid.UUID, date, hoje time.Time, motivo *string) (*models.ColaboradorLotacao, error) {
+	current, err := lotacoes.Current(colaboradorID, hoje)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := lotacoes.DeleteAfter(colaboradorID, hoje); err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		if date.Before(current.ValidFrom) {
+			return nil, dderr.NewWithCode(dderr.CodeColaboradorTransferInvalid, "data efetiva anterior ao início da lotação atual").
+				WithKey("transferencia.data_anterior_lotacao", nil).
+				WithFieldParam("data_efetiva", "min", current.ValidFrom.Format("2006-01-02"), "data efetiva anterior ao início da lotação atual")
+		}
+		current.ValidTo = nil
+		if date.Equal(current.ValidFrom) {
+			current.DepartamentoID = departamentoID
+			current.Motivo = motivo
+			return current, lotacoes.Update(current)
+		}
+		if current.DepartamentoID == departamentoID && !date.After(hoje) {
+			return current, lotacoes.Update(current)
+		}
+		current.ValidTo = &date
+		if err := lotacoes.Update(current); err != nil {
+			return nil, err
+		}
+	}
+
+	next := &models.ColaboradorLotacao{
+		ColaboradorID:  colaboradorID,
+		DepartamentoID: departamentoID,
+		ValidFrom:      date,
+		Motivo:         motivo,
+	}
+	return next, lotacoes.Create(next)
+}
+
+// closeLotacao encerra o histórico no desligamento: descarta o que estava
+// agendado depois da data e fecha a lotação vigente no dia seguinte a ela.
+func closeLotacao(lotacoes *repositories.LotacaoRepository, colaboradorID uuid.UUID, desligamento time.Time) error {
+	if _, err := lotacoes.DeleteAfter(colaboradorID, desligamento); err != nil {
+		return err
+	}
+	current, err := lotacoes.Current(colaboradorID, desligamento)
+	if err != nil || current == nil {
+		return err
+	}
+	end := desligamento.AddDate(0, 0, 1)
+	current.ValidTo = &end
+	return lotacoes.Update(current)
+}
+
+// lotacoesOn filtra as lotações vigentes em d, considerando o intervalo [ValidFrom, ValidTo).
+func lotacoesOn(list []repositories.LotacaoDetalhe, d time.Time) []repositories.LotacaoDetalhe {
+	var result []repositories.LotacaoDetalhe
+	for _, l := range list {
+		if l.ValidFrom.After(d) {
+			continue
+		}
+		if l.ValidTo != nil && !l.ValidTo.After(d) {
+			continue
+		}
+		result = append(result, l)
+	}
+	return result
+}
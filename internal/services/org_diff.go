@@ -0,0 +1,212 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+)
+
+// Tipos de alteração reportados pela comparação do organograma.
+const (
+	AlteracaoDepartamentoCriado     = "departamento_criado"
+	AlteracaoDepartamentoRenomeado  = "departamento_renomeado"
+	AlteracaoDepartamentoRealocado  = "departamento_realocado"
+	AlteracaoDepartamentoExcluido   = "departamento_excluido"
+	AlteracaoGerenteAlterado        = "gerente_alterado"
+	AlteracaoColaboradorAdmitido    = "colaborador_admitido"
+	AlteracaoColaboradorTransferido = "colaborador_transferido"
+	AlteracaoColaboradorDesligado   = "colaborador_desligado"
+)
+
+// OrgAlteracao é uma diferença entre os dois organogramas. De e Para trazem o
+// valor anterior e o novo: o nome no caso de renomeação, o departamento superior
+// na realocação, o gerente na troca de chefia e o departamento de lotação nas
+// alterações de colaborador.
+type OrgAlteracao struct {
+	Tipo           string     `json:"tipo" example:"colaborador_transferido"`
+	EntidadeID     uuid.UUID  `json:"entidade_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Nome           string     `json:"nome" example:"João Silva"`
+	DepartamentoID *uuid.UUID `json:"departamento_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DeID           *uuid.UUID `json:"de_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	De             *string    `json:"de,omitempty" example:"Financeiro"`
+	ParaID         *uuid.UUID `json:"para_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Para           *string    `json:"para,omitempty" example:"Tecnologia da Informação"`
+}
+
+// OrgDiff é a comparação do organograma entre o fim do dia From e o fim do dia To.
+type OrgDiff struct {
+	From       time.Time      `json:"from"`
+	To         time.Time      `json:"to"`
+	Resumo     map[string]int `json:"resumo"`
+	Alteracoes []OrgAlteracao `json:"alteracoes"`
+}
+
+// OrgService compara o estado da organização em datas diferentes.
+type OrgService struct {
+	deptRepo    *repositories.DepartamentoRepository
+	lotacaoRepo *repositories.LotacaoRepository
+}
+
+// NewOrgService cria uma nova instância de OrgService
+func NewOrgService(dr *repositories.DepartamentoRepository, lr *repositories.LotacaoRepository) *OrgService {
+	return &OrgService{deptRepo: dr, lotacaoRepo: lr}
+}
+
+// Diff compara o organograma do fim do dia from com o do fim do dia to, a partir
+// das versões de departamento e do histórico de lotações. A comparação é entre as
+// duas fotografias: quem entrou e saiu dentro do período não aparece.
+func (s *OrgService) Diff(from, to time.Time) (*OrgDiff, error) {
+	if to.Before(from) {
+		return nil, dderr.NewWithCode(dderr.CodeOrgDiffPeriodInvalid, "from deve ser anterior ou igual a to")
+	}
+
+	antes, err := s.snapshot(from)
+	if err != nil {
+		return nil, err
+	}
+	depois, err := s.snapshot(to)
+	if err != nil {
+		return nil, err
+	}
+
+	alteracoes := diffOrg(antes, depois)
+	resumo := make(map[string]int)
+	for _, a := range alteracoes {
+		resumo[a.Tipo]++
+	}
+	return &OrgDiff{From: from, To: to, Resumo: resumo, Alteracoes: alteracoes}, nil
+}
+
+// orgSnapshot é o estado da organização em uma data.
+type orgSnapshot struct {
+	departamentos []models.Departamento
+	lotacoes      []repositories.LotacaoVigente
+}
+
+func (s *OrgService) snapshot(d time.Time) (orgSnapshot, error) {
+	depts, err := s.deptRepo.FindAllAsOf(endOfDay(d))
+	if err != nil {
+		return orgSnapshot{}, err
+	}
+	lotacoes, err := s.lotacaoRepo.AllOn(d)
+	if err != nil {
+		return orgSnapshot{}, err
+	}
+	return orgSnapshot{departamentos: depts, lotacoes: lotacoes}, nil
+}
+
+// diffOrg lista as alterações entre os dois estados: primeiro as de departamento,
+// por nome, depois as de colaborador, por nome.
+func diffOrg(antes, depois orgSnapshot) []OrgAlteracao {
+	deptsAntes := make(map[uuid.UUID]models.Departamento, len(antes.departamentos))
+	for _, d := range antes.departamentos {
+		deptsAntes[d.ID] = d
+	}
+	deptsDepois := make(map[uuid.UUID]models.Departamento, len(depois.departamentos))
+	for _, d := range depois.departamentos {
+		deptsDepois[d.ID] = d
+	}
+	// nomes de departamento para exibição, preferindo o nome mais recente
+	nomes := make(map[uuid.UUID]string, len(deptsAntes)+len(deptsDepois))
+	for id, d := range deptsAntes {
+		nomes[id] = d.Nome
+	}
+	for id, d := range deptsDepois {
+		nomes[id] = d.Nome
+	}
+	ref := func(id *uuid.UUID) *string {
+		if id == nil {
+			return nil
+		}
+		nome := nomes[*id]
+		return &nome
+	}
+
+	var depts []OrgAlteracao
+	for id, d := range deptsDepois {
+		old, existia := deptsAntes[id]
+		if !existia {
+			depts = append(depts, OrgAlteracao{Tipo: AlteracaoDepartamentoCriado, EntidadeID: id, Nome: d.Nome,
+				ParaID: d.DepartamentoSuperiorID, Para: ref(d.DepartamentoSuperiorID)})
+			continue
+		}
+		if old.Nome != d.Nome {
+			de, para := old.Nome, d.Nome
+			depts = append(depts, OrgAlteracao{Tipo: AlteracaoDepartamentoRenomeado, EntidadeID: id, Nome: d.Nome, De: &de, Para: &para})
+		}
+		if !sameID(old.DepartamentoSuperiorID, d.DepartamentoSuperiorID) {
+			depts = append(depts, OrgAlteracao{Tipo: AlteracaoDepartamentoRealocado, EntidadeID: id, Nome: d.Nome,
+				DeID: old.DepartamentoSuperiorID, De: ref(old.DepartamentoSuperiorID),
+				ParaID: d.DepartamentoSuperiorID, Para: ref(d.DepartamentoSuperiorID)})
+		}
+		if !sameID(old.GerenteID, d.GerenteID) {
+			depts = append(depts, OrgAlteracao{Tipo: AlteracaoGerenteAlterado, EntidadeID: id, Nome: d.Nome,
+				DeID: old.GerenteID, De: gerenteNome(old),
+				ParaID: d.GerenteID, Para: gerenteNome(d)})
+		}
+	}
+	for id, d := range deptsAntes {
+		if _, existe := deptsDepois[id]; !existe {
+			depts = append(depts, OrgAlteracao{Tipo: AlteracaoDepartamentoExcluido, EntidadeID: id, Nome: d.Nome,
+				DeID: d.DepartamentoSuperiorID, De: ref(d.DepartamentoSuperiorID)})
+		}
+	}
+	sortAlteracoes(depts)
+
+	lotAntes := make(map[uuid.UUID]repositories.LotacaoVigente, len(antes.lotacoes))
+	for _, l := range antes.lotacoes {
+		lotAntes[l.ColaboradorID] = l
+	}
+	lotDepois := make(map[uuid.UUID]repositories.LotacaoVigente, len(depois.lotacoes))
+	for _, l := range depois.lotacoes {
+		lotDepois[l.ColaboradorID] = l
+	}
+
+	var colabs []OrgAlteracao
+	for id, l := range lotDepois {
+		para := l.DepartamentoID
+		old, estava := lotAntes[id]
+		switch {
+		case !estava:
+			colabs = append(colabs, OrgAlteracao{Tipo: AlteracaoColaboradorAdmitido, EntidadeID: id, Nome: l.ColaboradorNome,
+				DepartamentoID: &para, ParaID: &para, Para: ref(&para)})
+		case old.DepartamentoID != l.DepartamentoID:
+			de := old.DepartamentoID
+			colabs = append(colabs, OrgAlteracao{Tipo: AlteracaoColaboradorTransferido, EntidadeID: id, Nome: l.ColaboradorNome,
+				DepartamentoID: &para, DeID: &de, De: ref(&de), ParaID: &para, Para: ref(&para)})
+		}
+	}
+	for id, l := range lotAntes {
+		if _, esta := lotDepois[id]; !esta {
+			de := l.DepartamentoID
+			colabs = append(colabs, OrgAlteracao{Tipo: AlteracaoColaboradorDesligado, EntidadeID: id, Nome: l.ColaboradorNome,
+				DepartamentoID: &de, DeID: &de, De: ref(&de)})
+		}
+	}
+	sortAlteracoes(colabs)
+
+	return append(depts, colabs...)
+}
+
+func sortAlteracoes(list []OrgAlteracao) {
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Nome != list[j].Nome {
+			return list[i].Nome < list[j].Nome
+		}
+		if list[i].EntidadeID != list[j].EntidadeID {
+			return list[i].EntidadeID.String() < list[j].EntidadeID.String()
+		}
+		return list[i].Tipo < list[j].Tipo
+	})
+}
+
+func gerenteNome(d models.Departamento) *string {
+	if d.Gerente == nil {
+		return nil
+	}
+	return &d.Gerente.Nome
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/danubiobwm/company-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffOrgReportsDepartamentoAndColaboradorChanges(t *testing.T) {
+	diretoria, ti, vendas, novo := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	ana, bruno, carla, davi := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	antes := orgSnapshot{
+		departamentos: []models.Departamento{
+			{ID: diretoria, Nome: "Diretoria"},
+			{ID: ti, Nome: "TI", DepartamentoSuperiorID: &diretoria, GerenteID: &ana, Gerente: &models.Colaborador{ID: ana, Nome: "Ana"}},
+			{ID: vendas, Nome: "Vendas"},
+		},
+		lotacoes: []repositories.LotacaoVigente{
+			{ColaboradorID: ana, ColaboradorNome: "Ana", DepartamentoID: ti},
+			{ColaboradorID: bruno, ColaboradorNome: "Bruno", DepartamentoID: vendas},
+			{ColaboradorID: carla, ColaboradorNome: "Carla", DepartamentoID: ti},
+		},
+	}
+	depois := orgSnapshot{
+		departamentos: []models.Departamento{
+			{ID: diretoria, Nome: "Diretoria"},
+			{ID: ti, Nome: "Tecnologia", GerenteID: &bruno, Gerente: &models.Colaborador{ID: bruno, Nome: "Bruno"}},
+			{ID: novo, Nome: "Dados", DepartamentoSuperiorID: &ti},
+		},
+		lotacoes: []repositories.LotacaoVigente{
+			{ColaboradorID: ana, ColaboradorNome: "Ana", DepartamentoID: ti},
+			{ColaboradorID: bruno, ColaboradorNome: "Bruno", DepartamentoID: ti},
+			{ColaboradorID: davi, ColaboradorNome: "Davi", DepartamentoID: novo},
+		},
+	}
+
+	got := diffOrg(antes, depois)
+
+	tipos := make([]string, 0, len(got))
+	for _, a := range got {
+		tipos = append(tipos, a.Tipo+":"+a.Nome)
+	}
+	assert.Equal(t, []string{
+		"departamento_criado:Dados",
+		"departamento_realocado:Tecnologia",
+		"departamento_renomeado:Tecnologia",
+		"gerente_alterado:Tecnologia",
+		"departamento_excluido:Vendas",
+		"colaborador_transferido:Bruno",
+		"colaborador_desligado:Carla",
+		"colaborador_admitido:Davi",
+	}, tipos)
+
+	transferido := got[5]
+	require.NotNil(t, transferido.De)
+	require.NotNil(t, transferido.Para)
+	assert.Equal(t, "Vendas", *transferido.De)
+	assert.Equal(t, "Tecnologia", *transferido.Para)
+	assert.Nil(t, got[1].ParaID)
+}
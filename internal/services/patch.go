@@ -0,0 +1,76 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+)
+
+// patchField descreve um campo que pode ser alterado por PATCH. Campos anuláveis
+// são limpos quando o patch traz null.
+type patchField struct {
+	nullable bool
+}
+
+// patchError é o erro de um patch inválido, com o campo responsável e a regra
+// violada (readonly, required ou type).
+func patchError(field, code, msg string) error {
+	return dderr.NewWithCode(dderr.CodePatchInvalid, msg).
+		WithKey("patch."+code, map[string]interface{}{"field": field}).
+		WithField(field, code, msg)
+}
+
+// applyMergePatch aplica um JSON Merge Patch (RFC 7396) sobre target, que deve
+// ser ponteiro para struct. Só os campos listados em fields podem ser alterados;
+// null remove o valor dos campos anuláveis e os ausentes ficam como estão.
+func applyMergePatch(target interface{}, patch []byte, fields map[string]patchField) error {
+	var changes map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return dderr.NewWithCode(dderr.CodePatchInvalid, "o patch deve ser um objeto JSON").
+			WithKey("patch.objeto", nil)
+	}
+
+	current, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(current, &doc); err != nil {
+		return err
+	}
+
+	typ := reflect.TypeOf(target).Elem()
+	for name, value := range changes {
+		field, ok := fields[name]
+		if !ok {
+			return patchError(name, "readonly", "campo "+name+" não pode ser alterado")
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			if !field.nullable {
+				return patchError(name, "required", "campo "+name+" não pode ser nulo")
+			}
+			delete(doc, name)
+			continue
+		}
+		// valida o tipo de cada campo isoladamente para apontar qual está errado
+		single, _ := json.Marshal(map[string]json.RawMessage{name: value})
+		if err := json.Unmarshal(single, reflect.New(typ).Interface()); err != nil {
+			return patchError(name, "type", "valor inválido para o campo "+name)
+		}
+		doc[name] = value
+	}
+
+	merged, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	// decodifica sobre um valor zerado para que os campos removidos fiquem nulos
+	fresh := reflect.New(typ)
+	if err := json.Unmarshal(merged, fresh.Interface()); err != nil {
+		return err
+	}
+	reflect.ValueOf(target).Elem().Set(fresh.Elem())
+	return nil
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	dderr "github.com/danubiobwm/company-api/internal/errors"
+	"github.com/danubiobwm/company-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	rg := "MG123"
+	email := "ana@empresa.com.br"
+	deptID := uuid.New()
+	original := models.Colaborador{ID: uuid.New(), Nome: "Ana", CPF: "52998224725", RG: &rg, Email: &email,
+		DepartamentoID: deptID, Status: models.StatusAtivo, Version: 3}
+
+	c := original
+	err := applyMergePatch(&c, []byte(`{"nome": "Ana Souza", "rg": null}`), colaboradorPatchFields)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana Souza", c.Nome)
+	assert.Nil(t, c.RG)
+	assert.Equal(t, email, *c.Email)
+	assert.Equal(t, deptID, c.DepartamentoID)
+	assert.Equal(t, 3, c.Version)
+	assert.Equal(t, "MG123", *original.RG, "o registro original não pode ser alterado")
+
+	cases := []struct {
+		patch       string
+		field, code string
+	}{
+		{`{"nome": null}`, "nome", "required"},
+		{`{"version": 7}`, "version", "readonly"},
+		{`{"data_desligamento": "2025-01-01T00:00:00Z"}`, "data_desligamento", "readonly"},
+		{`{"departamento_id": "não é uuid"}`, "departamento_id", "type"},
+		{`{"nome": 10}`, "nome", "type"},
+		{`[]`, "", ""},
+		{`null`, "", ""},
+	}
+	for _, tc := range cases {
+		c := original
+		err := applyMergePatch(&c, []byte(tc.patch), colaboradorPatchFields)
+		var domainErr *dderr.DomainError
+		if assert.True(t, errors.As(err, &domainErr), tc.patch) {
+			assert.Equal(t, dderr.CodePatchInvalid, domainErr.Code, tc.patch)
+			if tc.field == "" {
+				assert.Empty(t, domainErr.Fields, tc.patch)
+			} else if assert.Len(t, domainErr.Fields, 1, tc.patch) {
+				assert.Equal(t, tc.field, domainErr.Fields[0].Field, tc.patch)
+				assert.Equal(t, tc.code, domainErr.Fields[0].Code, tc.patch)
+			}
+		}
+		assert.Equal(t, original.Nome, c.Nome, tc.patch)
+	}
+}